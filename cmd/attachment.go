@@ -41,12 +41,16 @@ var attachmentListCmd = &cobra.Command{
 	Long:  `List all attachments (both files and URLs) on a Linear issue.`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		issueID := args[0]
-
 		// Get output flags
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		// Get flags
 		limit, _ := cmd.Flags().GetInt("limit")
 		sortFlag, _ := cmd.Flags().GetString("sort")
@@ -78,7 +82,7 @@ var attachmentListCmd = &cobra.Command{
 		}
 
 		client := api.NewClient(authHeader)
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Convert limit to pointer
 		var limitPtr *int
@@ -101,6 +105,11 @@ var attachmentListCmd = &cobra.Command{
 
 		attachments := resp.Issue.Attachments.Nodes
 
+		if summaryOnly() {
+			printSummary(len(attachments), "attachments", "")
+			return
+		}
+
 		// Render output
 		if jsonOut {
 			output.JSON(attachments)
@@ -168,12 +177,16 @@ var attachmentCreateCmd = &cobra.Command{
 	Long:  `Create an attachment linking to an external URL (e.g., GitHub PR, documentation).`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		issueID := args[0]
-
 		// Get output flags
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		// Get flags
 		url, _ := cmd.Flags().GetString("url")
 		title, _ := cmd.Flags().GetString("title")
@@ -227,7 +240,7 @@ var attachmentCreateCmd = &cobra.Command{
 		}
 
 		client := api.NewClient(authHeader)
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Call API
 		resp, err := api.AttachmentCreate(ctx, client, &input)
@@ -524,12 +537,16 @@ Example:
     --file screenshot.png --title "Bug Screenshot"`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		issueID := args[0]
-
 		// Get output flags
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		// Parse file attachments from flags
 		files, err := parseFileFlags(cmd)
 		if err != nil {
@@ -606,7 +623,7 @@ Example:
 		}
 
 		client := api.NewClient(authHeader)
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Upload files and collect results
 		if !jsonOut {
@@ -615,7 +632,13 @@ Example:
 		var results []uploadResult
 		var succeeded, failed int
 
-		for _, file := range files {
+		for i, file := range files {
+			if i > 0 {
+				if err := waitPageDelay(ctx); err != nil {
+					output.Error(fmt.Sprintf("Upload interrupted: %v", err), plaintext, jsonOut)
+					os.Exit(1)
+				}
+			}
 			err := uploadFileToLinear(ctx, client, file, issueID, jsonOut)
 			result := uploadResult{
 				Filename: filepath.Base(file.path),
@@ -759,7 +782,7 @@ To change a file or URL, delete the old attachment and create a new one.`,
 		}
 
 		client := api.NewClient(authHeader)
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Build update input
 		input := api.AttachmentUpdateInput{
@@ -814,8 +837,11 @@ func init() {
 var attachmentDeleteCmd = &cobra.Command{
 	Use:   "delete <attachment-id>",
 	Short: "Delete an attachment",
-	Long:  `Delete an attachment from an issue. This action cannot be undone.`,
-	Args:  cobra.ExactArgs(1),
+	Long: `Delete an attachment from an issue. This action cannot be undone.
+
+You will be asked to confirm. Use --yes (or --force when require_confirmation
+is set) to skip the prompt in scripts.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		attachmentID := args[0]
 
@@ -823,6 +849,11 @@ var attachmentDeleteCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		if err := confirmDestructive(cmd, fmt.Sprintf("Delete attachment %s", attachmentID)); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		// Get auth and create client
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
@@ -831,7 +862,7 @@ var attachmentDeleteCmd = &cobra.Command{
 		}
 
 		client := api.NewClient(authHeader)
-		ctx := context.Background()
+		ctx := cmd.Context()
 
 		// Call API
 		resp, err := api.AttachmentDelete(ctx, client, attachmentID)
@@ -857,4 +888,5 @@ var attachmentDeleteCmd = &cobra.Command{
 
 func init() {
 	attachmentCmd.AddCommand(attachmentDeleteCmd)
+	addConfirmFlags(attachmentDeleteCmd)
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/shanedolley/lincli/pkg/api"
 	"github.com/shanedolley/lincli/pkg/auth"
 	"github.com/shanedolley/lincli/pkg/output"
 	"github.com/fatih/color"
@@ -83,17 +84,41 @@ var statusCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// Optionally fetch team memberships
+		showTeams, _ := cmd.Flags().GetBool("teams")
+		var teams []viewerTeam
+		if showTeams {
+			authHeader, err := auth.GetAuthHeader()
+			if err == nil {
+				teams, err = fetchViewerTeams(cmd.Context(), api.NewClient(authHeader))
+			}
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get teams: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
 		if jsonOut {
-			output.JSON(map[string]interface{}{
+			result := map[string]interface{}{
 				"authenticated": true,
 				"user":          user,
-			})
+			}
+			if showTeams {
+				result["teams"] = teams
+			}
+			output.JSON(result)
 		} else if plaintext {
 			fmt.Printf("Authenticated as: %s (%s)\n", user.Name, user.Email)
+			if showTeams {
+				printViewerTeams(teams, true)
+			}
 		} else {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Authenticated"))
 			fmt.Printf("User: %s\n", color.New(color.FgCyan).Sprint(user.Name))
 			fmt.Printf("Email: %s\n", color.New(color.FgCyan).Sprint(user.Email))
+			if showTeams {
+				printViewerTeams(teams, false)
+			}
 		}
 	},
 }
@@ -128,7 +153,11 @@ var logoutCmd = &cobra.Command{
 var whoamiCmd = &cobra.Command{
 	Use:   "whoami",
 	Short: "Show current user",
-	Long:  `Display information about the currently authenticated user.`,
+	Long: `Display information about the currently authenticated user.
+
+Examples:
+  lincli whoami          # Show who you're authenticated as
+  lincli whoami --teams  # Also list your teams, their keys, and your role`,
 	Run: func(cmd *cobra.Command, args []string) {
 		statusCmd.Run(cmd, args)
 	},
@@ -142,4 +171,7 @@ func init() {
 
 	// Add whoami as a top-level command too
 	rootCmd.AddCommand(whoamiCmd)
+
+	statusCmd.Flags().Bool("teams", false, "Show your team memberships and roles")
+	whoamiCmd.Flags().Bool("teams", false, "Show your team memberships and roles")
 }
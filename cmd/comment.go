@@ -35,7 +35,11 @@ var commentListCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
@@ -87,12 +91,21 @@ var commentListCmd = &cobra.Command{
 		}
 
 		// Get comments using generated function
-		resp, err := api.ListComments(context.Background(), client, issueID, limitPtr, nil, orderByEnum)
+		resp, err := api.ListComments(cmd.Context(), client, issueID, limitPtr, nil, orderByEnum)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 
+		if summaryOnly() {
+			count := 0
+			if resp.Issue != nil && resp.Issue.Comments != nil {
+				count = len(resp.Issue.Comments.Nodes)
+			}
+			printSummary(count, "comments", "")
+			return
+		}
+
 		// Check if no comments
 		if resp.Issue == nil || resp.Issue.Comments == nil || len(resp.Issue.Comments.Nodes) == 0 {
 			if jsonOut {
@@ -123,10 +136,10 @@ var commentListCmd = &cobra.Command{
 			}
 		} else {
 			// Rich display
-			fmt.Printf("\n%s Comments on %s (%d)\n\n",
+			fmt.Printf("\n%s Comments on %s (%s)\n\n",
 				color.New(color.FgCyan, color.Bold).Sprint("💬"),
 				color.New(color.FgCyan).Sprint(issueID),
-				len(comments))
+				numberFormat().Count(len(comments)))
 
 			for i, comment := range comments {
 				if i > 0 {
@@ -160,7 +173,11 @@ var commentCreateCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		issueID := args[0]
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
 
 		// Get auth header
 		authHeader, err := auth.GetAuthHeader()
@@ -186,7 +203,7 @@ var commentCreateCmd = &cobra.Command{
 		}
 
 		// Create comment
-		createResp, err := api.CreateComment(context.Background(), client, input)
+		createResp, err := api.CreateComment(cmd.Context(), client, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -209,6 +226,39 @@ var commentCreateCmd = &cobra.Command{
 	},
 }
 
+// commentPageSize is the page size used when fetching every comment on an issue
+const commentPageSize = 100
+
+// fetchAllComments pages through ListComments until every comment on the
+// issue (including replies) has been fetched
+func fetchAllComments(ctx context.Context, client *api.Client, issueID string) ([]*api.ListCommentsIssueCommentsCommentConnectionNodesComment, error) {
+	var comments []*api.ListCommentsIssueCommentsCommentConnectionNodesComment
+	var after *string
+	first := commentPageSize
+
+	for {
+		resp, err := api.ListComments(ctx, client, issueID, &first, after, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Issue == nil || resp.Issue.Comments == nil {
+			return comments, nil
+		}
+
+		comments = append(comments, resp.Issue.Comments.Nodes...)
+
+		pageInfo := resp.Issue.Comments.PageInfo
+		if pageInfo == nil || !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return comments, nil
+		}
+		after = pageInfo.EndCursor
+
+		if err := waitPageDelay(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
 // formatTimeAgo formats a time as a human-readable "time ago" string
 func formatTimeAgo(t time.Time) string {
 	duration := time.Since(t)
@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// addConfirmFlags registers the standard bypass flags for destructive commands
+func addConfirmFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Skip the confirmation prompt (ignored when require_confirmation is set)")
+	cmd.Flags().Bool("force", false, "Skip the confirmation prompt unconditionally")
+}
+
+// confirmDestructive asks the user to confirm a destructive action.
+//
+// --force always skips the prompt. --yes skips it unless require_confirmation
+// (or the global --confirm-destructive flag) is set. Without a bypass flag the
+// user is prompted on stdin; when stdin is not a terminal or --json is in use
+// the action is refused, so scripts must opt in explicitly.
+func confirmDestructive(cmd *cobra.Command, action string) error {
+	return confirmAction(cmd, action, os.Stdin, os.Stderr, stdinIsTerminal())
+}
+
+// confirmAction implements confirmDestructive with the prompt streams and the
+// terminal check passed in
+func confirmAction(cmd *cobra.Command, action string, in io.Reader, out io.Writer, interactive bool) error {
+	if force, _ := cmd.Flags().GetBool("force"); force {
+		return nil
+	}
+
+	requireConfirmation := viper.GetBool("require_confirmation")
+	if yes, _ := cmd.Flags().GetBool("yes"); yes && !requireConfirmation {
+		return nil
+	}
+
+	if viper.GetBool("json") || !interactive {
+		if requireConfirmation {
+			return fmt.Errorf("%s requires confirmation (require_confirmation is set); pass --force to proceed non-interactively", action)
+		}
+		return fmt.Errorf("%s requires confirmation; pass --yes to proceed non-interactively", action)
+	}
+
+	if !promptConfirm(in, out, action) {
+		return fmt.Errorf("aborted")
+	}
+	return nil
+}
+
+// promptConfirm writes a y/N prompt to out and reads the answer from in.
+// Only "y" or "yes" (case-insensitive) count as confirmation.
+func promptConfirm(in io.Reader, out io.Writer, action string) bool {
+	fmt.Fprintf(out, "%s? This cannot be undone. [y/N]: ", action)
+
+	answer, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && answer == "" {
+		fmt.Fprintln(out)
+		return false
+	}
+
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// stdinIsTerminal reports whether stdin is an interactive terminal
+func stdinIsTerminal() bool {
+	info, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
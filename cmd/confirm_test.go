@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func TestPromptConfirm(t *testing.T) {
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{"y\n", true},
+		{"yes\n", true},
+		{"YES\n", true},
+		{"  y  \n", true},
+		{"y", true},
+		{"n\n", false},
+		{"N\n", false},
+		{"\n", false},
+		{"yep\n", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(strings.TrimSpace(tt.input), func(t *testing.T) {
+			var out bytes.Buffer
+			got := promptConfirm(strings.NewReader(tt.input), &out, "Delete attachment A")
+			if got != tt.want {
+				t.Errorf("promptConfirm(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+			if !strings.Contains(out.String(), "Delete attachment A? This cannot be undone. [y/N]: ") {
+				t.Errorf("prompt = %q", out.String())
+			}
+		})
+	}
+}
+
+func TestConfirmAction(t *testing.T) {
+	tests := []struct {
+		name        string
+		force       bool
+		yes         bool
+		require     bool
+		jsonOut     bool
+		interactive bool
+		input       string
+		wantErr     string
+	}{
+		{name: "force", force: true},
+		{name: "force with require_confirmation", force: true, require: true},
+		{name: "yes", yes: true},
+		{name: "yes with require_confirmation, non-TTY", yes: true, require: true, wantErr: "pass --force"},
+		{name: "yes with require_confirmation, TTY confirms", yes: true, require: true, interactive: true, input: "y\n"},
+		{name: "non-TTY", wantErr: "pass --yes"},
+		{name: "json on a TTY", jsonOut: true, interactive: true, wantErr: "pass --yes"},
+		{name: "TTY confirms", interactive: true, input: "yes\n"},
+		{name: "TTY declines", interactive: true, input: "n\n", wantErr: "aborted"},
+		{name: "TTY EOF", interactive: true, input: "", wantErr: "aborted"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("require_confirmation", tt.require)
+			viper.Set("json", tt.jsonOut)
+			defer viper.Set("require_confirmation", false)
+			defer viper.Set("json", false)
+
+			cmd := &cobra.Command{}
+			addConfirmFlags(cmd)
+			if tt.force {
+				_ = cmd.Flags().Set("force", "true")
+			}
+			if tt.yes {
+				_ = cmd.Flags().Set("yes", "true")
+			}
+
+			var out bytes.Buffer
+			err := confirmAction(cmd, "Delete attachment A", strings.NewReader(tt.input), &out, tt.interactive)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/shanedolley/lincli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// cycleKeywords maps the --cycle keywords to the cycle they select
+var cycleKeywords = map[string]string{
+	"current":  "active",
+	"active":   "active",
+	"next":     "next",
+	"upcoming": "next",
+}
+
+// resolveCycleNumber turns a --cycle value into a cycle number. Numbers are
+// used as given; current/active and next/upcoming are looked up on the team,
+// so they require teamKey.
+func resolveCycleNumber(ctx context.Context, client *api.Client, teamKey, value string) (float64, error) {
+	number, which, err := parseCycleValue(value)
+	if err != nil || which == "" {
+		return number, err
+	}
+	if teamKey == "" {
+		return 0, fmt.Errorf("--cycle %s requires --team, since each team has its own cycles", strings.ToLower(strings.TrimSpace(value)))
+	}
+
+	resp, err := api.GetTeamCycles(ctx, client, teamKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get cycles for team %s: %v", teamKey, err)
+	}
+	return chooseCycle(resp.Team, teamKey, which)
+}
+
+// parseCycleValue splits a --cycle value into either a cycle number or the
+// keyword target ("active" or "next") that has to be looked up on the team
+func parseCycleValue(value string) (number float64, which string, err error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if n, err := strconv.Atoi(value); err == nil && n > 0 {
+		return float64(n), "", nil
+	}
+
+	which, ok := cycleKeywords[value]
+	if !ok {
+		return 0, "", fmt.Errorf("invalid cycle %q; use a cycle number, current/active, or next/upcoming", value)
+	}
+	return 0, which, nil
+}
+
+// chooseCycle returns the number of the team's active or next cycle
+func chooseCycle(team *api.GetTeamCyclesTeam, teamKey, which string) (float64, error) {
+	if team == nil {
+		return 0, fmt.Errorf("team %s not found", teamKey)
+	}
+	if !team.CyclesEnabled {
+		return 0, fmt.Errorf("team %s does not have cycles enabled", team.Key)
+	}
+
+	if which == "active" {
+		if team.ActiveCycle == nil {
+			return 0, fmt.Errorf("team %s has no active cycle", team.Key)
+		}
+		return team.ActiveCycle.Number, nil
+	}
+
+	if team.Cycles == nil || len(team.Cycles.Nodes) == 0 {
+		return 0, fmt.Errorf("team %s has no upcoming cycle", team.Key)
+	}
+	return team.Cycles.Nodes[0].Number, nil
+}
+
+// cycleFilter builds the issue filter for a --cycle value, printing the
+// resolved cycle number under --verbose
+func cycleFilter(ctx context.Context, client *api.Client, teamKey, value string) (*api.NullableCycleFilter, error) {
+	number, err := resolveCycleNumber(ctx, client, teamKey, value)
+	if err != nil {
+		return nil, err
+	}
+
+	if viper.GetBool("verbose") {
+		fmt.Fprintf(os.Stderr, "Cycle: %s (cycle %g)\n", value, number)
+	}
+	return &api.NullableCycleFilter{Number: &api.NumberComparator{Eq: &number}}, nil
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/shanedolley/lincli/pkg/api"
+)
+
+func TestParseCycleValue(t *testing.T) {
+	tests := []struct {
+		value      string
+		wantNumber float64
+		wantWhich  string
+		wantErr    bool
+	}{
+		{value: "12", wantNumber: 12},
+		{value: "current", wantWhich: "active"},
+		{value: " Active ", wantWhich: "active"},
+		{value: "next", wantWhich: "next"},
+		{value: "UPCOMING", wantWhich: "next"},
+		{value: "0", wantErr: true},
+		{value: "-3", wantErr: true},
+		{value: "previous", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			number, which, err := parseCycleValue(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseCycleValue(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if number != tt.wantNumber || which != tt.wantWhich {
+				t.Errorf("parseCycleValue(%q) = %g, %q; want %g, %q", tt.value, number, which, tt.wantNumber, tt.wantWhich)
+			}
+		})
+	}
+}
+
+func TestChooseCycle(t *testing.T) {
+	// ENG is on cycle 41, with 42 coming up
+	eng := &api.GetTeamCyclesTeam{
+		Key:           "ENG",
+		CyclesEnabled: true,
+		ActiveCycle:   &api.GetTeamCyclesTeamActiveCycle{Number: 41},
+		Cycles: &api.GetTeamCyclesTeamCyclesCycleConnection{
+			Nodes: []*api.GetTeamCyclesTeamCyclesCycleConnectionNodesCycle{{Number: 42}},
+		},
+	}
+	disabled := &api.GetTeamCyclesTeam{Key: "OPS"}
+	between := &api.GetTeamCyclesTeam{Key: "DES", CyclesEnabled: true, Cycles: &api.GetTeamCyclesTeamCyclesCycleConnection{}}
+
+	tests := []struct {
+		name    string
+		team    *api.GetTeamCyclesTeam
+		value   string
+		want    float64
+		wantErr string
+	}{
+		{name: "current", team: eng, value: "current", want: 41},
+		{name: "active", team: eng, value: "active", want: 41},
+		{name: "next", team: eng, value: "next", want: 42},
+		{name: "upcoming", team: eng, value: "upcoming", want: 42},
+		{name: "cycles disabled", team: disabled, value: "current", wantErr: "does not have cycles enabled"},
+		{name: "no active cycle", team: between, value: "current", wantErr: "has no active cycle"},
+		{name: "no upcoming cycle", team: between, value: "next", wantErr: "has no upcoming cycle"},
+		{name: "team not found", value: "next", wantErr: "team XYZ not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, which, err := parseCycleValue(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := chooseCycle(tt.team, "XYZ", which)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want it to contain %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got cycle %g, want %g", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveCycleNumberWithoutAPI(t *testing.T) {
+	// Numbers and the missing --team check never reach the API, so no client is needed
+	if got, err := resolveCycleNumber(context.Background(), nil, "", "7"); err != nil || got != 7 {
+		t.Errorf("resolveCycleNumber(7) = %g, %v; want 7", got, err)
+	}
+
+	for _, value := range []string{"current", "next"} {
+		_, err := resolveCycleNumber(context.Background(), nil, "", value)
+		want := "--cycle " + value + " requires --team"
+		if err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("resolveCycleNumber(%q) without team = %v, want %q", value, err, want)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+var (
+	issueKeyPattern  = regexp.MustCompile(`^([A-Za-z][A-Za-z0-9]*)-(\d+)$`)
+	issueNumPattern  = regexp.MustCompile(`^\d+$`)
+	issueUUIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	issueURLPattern  = regexp.MustCompile(`^https?://linear\.app/[^/]+/issue/([A-Za-z][A-Za-z0-9]*-\d+)(?:[/?#].*)?$`)
+)
+
+// resolveIssueIdentifier normalizes a user-supplied issue reference into a
+// form the API accepts. It handles:
+//   - identifiers in any case ("lin-123" -> "LIN-123")
+//   - bare numbers, prefixed with the default_team config key ("123" -> "ENG-123")
+//   - Linear issue URLs ("https://linear.app/acme/issue/LIN-123/title" -> "LIN-123")
+//   - issue UUIDs, which are passed through in lowercase
+func resolveIssueIdentifier(ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return "", fmt.Errorf("issue identifier is empty")
+	}
+
+	if m := issueURLPattern.FindStringSubmatch(ref); m != nil {
+		ref = m[1]
+	}
+
+	if issueUUIDPattern.MatchString(ref) {
+		return strings.ToLower(ref), nil
+	}
+
+	if m := issueKeyPattern.FindStringSubmatch(ref); m != nil {
+		return strings.ToUpper(m[1]) + "-" + m[2], nil
+	}
+
+	if issueNumPattern.MatchString(ref) {
+		team := strings.TrimSpace(viper.GetString("default_team"))
+		if team == "" {
+			return "", fmt.Errorf("%q has no team prefix; use the full identifier (e.g. ENG-%s) or set default_team in your config", ref, ref)
+		}
+		return strings.ToUpper(team) + "-" + ref, nil
+	}
+
+	return "", fmt.Errorf("%q is not a valid issue identifier; expected TEAM-123, an issue URL, or an issue UUID", ref)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestResolveIssueIdentifier(t *testing.T) {
+	tests := []struct {
+		name        string
+		ref         string
+		defaultTeam string
+		want        string
+		wantErr     bool
+	}{
+		{name: "identifier", ref: "LIN-123", want: "LIN-123"},
+		{name: "lowercase", ref: "lin-123", want: "LIN-123"},
+		{name: "mixed case with digits in key", ref: "Eng2-7", want: "ENG2-7"},
+		{name: "surrounding space", ref: "  lin-123 ", want: "LIN-123"},
+		{name: "bare number with default team", ref: "123", defaultTeam: "eng", want: "ENG-123"},
+		{name: "bare number without default team", ref: "123", wantErr: true},
+		{name: "url", ref: "https://linear.app/acme/issue/LIN-123/fix-login", want: "LIN-123"},
+		{name: "url without slug", ref: "https://linear.app/acme/issue/lin-123", want: "LIN-123"},
+		{name: "url with query", ref: "https://linear.app/acme/issue/LIN-123?foo=bar", want: "LIN-123"},
+		{name: "uuid", ref: "0F8FAD5B-D9CB-469F-A165-70867728950E", want: "0f8fad5b-d9cb-469f-a165-70867728950e"},
+		{name: "empty", ref: " ", wantErr: true},
+		{name: "garbage", ref: "not an issue", wantErr: true},
+		{name: "other url", ref: "https://example.com/issue/LIN-123", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("default_team", tt.defaultTeam)
+			defer viper.Set("default_team", "")
+
+			got, err := resolveIssueIdentifier(tt.ref)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveIssueIdentifier(%q) error = %v, wantErr %v", tt.ref, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveIssueIdentifier(%q) = %q, want %q", tt.ref, got, tt.want)
+			}
+		})
+	}
+}
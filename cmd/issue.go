@@ -1,10 +1,16 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/shanedolley/lincli/pkg/api"
 	"github.com/shanedolley/lincli/pkg/auth"
@@ -24,6 +30,8 @@ var issueCmd = &cobra.Command{
 Examples:
   lincli issue list --assignee me --state "In Progress"
   lincli issue ls -a me -s "In Progress"
+  lincli issue list --team ENG --state Todo --state "In Progress"
+  lincli issue list --team ENG --cycle current
   lincli issue list --include-completed  # Show all issues including completed
   lincli issue list --newer-than 3_weeks_ago  # Show issues from last 3 weeks
   lincli issue search "login bug" --team ENG
@@ -35,7 +43,27 @@ var issueListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List issues",
-	Long:    `List Linear issues with optional filtering.`,
+	Long: `List Linear issues with optional filtering.
+
+--state may be repeated or comma-separated; issues in any of the named states
+match. With --team, names are checked against that team's workflow states.
+
+Prefix --state, --assignee, or --team values with "!" to exclude them, e.g.
+--assignee '!me' or --state '!Blocked'. Quote the value so your shell does not
+treat "!" as history expansion. Excluding an assignee keeps unassigned issues,
+and excluded states alone still hide completed and canceled issues unless
+--include-completed is given.
+
+--first-response-pending lists triage and unstarted issues that nobody but
+their creator has commented on yet. Comments from integrations are ignored,
+only the first 50 comments of each issue are checked, and a status change or
+assignment without a comment still counts as pending.
+
+Use --all to fetch every matching issue instead of the first --limit results.
+--all orders by creation time and ignores issues created after the export
+started, so pages cannot shift while they are being fetched. Choosing a
+mutable order such as --sort updated with --all may skip or repeat issues
+that change mid-export.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -50,6 +78,22 @@ var issueListCmd = &cobra.Command{
 
 		// Build typed filter from flags
 		filterTyped := buildIssueFilterTyped(cmd)
+		printCreatedWindow(cmd, filterTyped)
+		if err := validateStateFilter(cmd.Context(), client, cmd, filterTyped); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if cycle, _ := cmd.Flags().GetString("cycle"); cycle != "" {
+			teamKey, _ := cmd.Flags().GetString("team")
+			if _, negate := negated(teamKey); negate {
+				teamKey = ""
+			}
+			filterTyped.Cycle, err = cycleFilter(cmd.Context(), client, teamKey, cycle)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
@@ -82,93 +126,175 @@ var issueListCmd = &cobra.Command{
 			limitPtr = &limit
 		}
 
-		resp, err := api.ListIssues(context.Background(), client, filterTyped, limitPtr, nil, orderByEnum)
+		fetchAll, _ := cmd.Flags().GetBool("all")
+
+		firstResponsePending, _ := cmd.Flags().GetBool("first-response-pending")
+
+		// Stream --all JSON exports page by page so memory stays flat
+		if fetchAll && jsonOut && !firstResponsePending && !summaryOnly() {
+			stream := output.NewJSONArrayStream(os.Stdout)
+			err = forEachIssuePage(cmd.Context(), client, filterTyped, stableExportOrder(cmd, orderByEnum), func(page []*api.ListIssuesIssuesIssueConnectionNodesIssue) error {
+				for _, node := range page {
+					if err := stream.Write(node); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				if stream.Len() == 0 {
+					output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
+					os.Exit(1)
+				}
+				// Part of the array is already on stdout: close it so stdout
+				// stays valid JSON, and report the error on stderr only
+				_ = stream.Close()
+				output.Error(fmt.Sprintf("Failed to fetch issues after %d were written: %v", stream.Len(), err), plaintext, false)
+				os.Exit(1)
+			}
+			if stream.Len() == 0 {
+				output.Info("No issues found", plaintext, jsonOut)
+				return
+			}
+			_ = stream.Close()
+			return
+		}
+
+		var nodes []*api.ListIssuesIssuesIssueConnectionNodesIssue
+		hasNextPage := false
+		if firstResponsePending {
+			pendingLimit := limit
+			if fetchAll {
+				pendingLimit = 0
+				orderByEnum = stableExportOrder(cmd, orderByEnum)
+			}
+			nodes, hasNextPage, err = fetchFirstResponsePending(cmd.Context(), client, filterTyped, orderByEnum, pendingLimit)
+		} else if fetchAll {
+			orderByEnum = stableExportOrder(cmd, orderByEnum)
+			nodes, err = fetchAllIssues(cmd.Context(), client, filterTyped, orderByEnum)
+		} else {
+			var resp *api.ListIssuesResponse
+			resp, err = api.ListIssues(cmd.Context(), client, filterTyped, limitPtr, nil, orderByEnum)
+			if err == nil {
+				nodes = resp.Issues.Nodes
+				hasNextPage = resp.Issues.PageInfo.HasNextPage
+			}
+		}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 
+		olderIssuesExist := func(older *api.IssueFilter) (bool, error) {
+			first := 1
+			resp, err := api.CountIssues(cmd.Context(), client, older, &first, nil)
+			if err != nil {
+				return false, err
+			}
+			return resp.Issues != nil && len(resp.Issues.Nodes) > 0, nil
+		}
+
+		if summaryOnly() {
+			printTotal(len(nodes), "issues")
+			printDefaultWindowNote(cmd, filterTyped, hasNextPage, olderIssuesExist)
+			return
+		}
+
 		// Check if empty
-		if len(resp.Issues.Nodes) == 0 {
+		if len(nodes) == 0 {
 			output.Info("No issues found", plaintext, jsonOut)
+			printDefaultWindowNote(cmd, filterTyped, false, olderIssuesExist)
 			return
 		}
 
 		// JSON output
 		if jsonOut {
-			output.JSON(resp.Issues.Nodes)
-			return
-		}
-
-		// Plaintext output
-		if plaintext {
-			fmt.Println("# Issues")
-			for _, node := range resp.Issues.Nodes {
-				f := node.IssueListFields
-				fmt.Printf("## %s\n", f.Title)
-				fmt.Printf("- **ID**: %s\n", f.Identifier)
-				if f.State != nil {
-					fmt.Printf("- **State**: %s\n", f.State.Name)
-				}
-				if f.Assignee != nil {
-					fmt.Printf("- **Assignee**: %s\n", f.Assignee.Name)
-				} else {
-					fmt.Printf("- **Assignee**: Unassigned\n")
-				}
-				if f.Team != nil {
-					fmt.Printf("- **Team**: %s\n", f.Team.Key)
-				}
-				fmt.Printf("- **Created**: %s\n", f.CreatedAt.Format("2006-01-02"))
-				fmt.Printf("- **URL**: %s\n", f.Url)
-				if f.Description != nil && *f.Description != "" {
-					fmt.Printf("- **Description**: %s\n", *f.Description)
-				}
-				fmt.Println()
-			}
-			fmt.Printf("\nTotal: %d issues\n", len(resp.Issues.Nodes))
+			output.JSON(nodes)
 			return
 		}
 
-		// Table output
-		headers := []string{"Title", "State", "Assignee", "Team", "Created", "URL"}
-		rows := make([][]string, len(resp.Issues.Nodes))
+		prettyPriority, _ := cmd.Flags().GetBool("pretty-priority")
+		printIssueList("Issues", 1, nodes, plaintext, prettyPriority)
+		printTotal(len(nodes), "issues")
+		printDefaultWindowNote(cmd, filterTyped, hasNextPage, olderIssuesExist)
+	},
+}
 
-		for i, node := range resp.Issues.Nodes {
+// printIssueList renders issues as a markdown list under a heading of the
+// given level (plaintext), so it can be nested in other output, or as a
+// table, optionally with a priority column
+func printIssueList(heading string, level int, nodes []*api.ListIssuesIssuesIssueConnectionNodesIssue, plaintext, prettyPriority bool) {
+	// Plaintext output
+	if plaintext {
+		fmt.Printf("%s %s\n", strings.Repeat("#", level), heading)
+		for _, node := range nodes {
 			f := node.IssueListFields
-
-			assignee := "Unassigned"
+			fmt.Printf("%s %s\n", strings.Repeat("#", level+1), f.Title)
+			fmt.Printf("- **ID**: %s\n", f.Identifier)
+			if f.State != nil {
+				fmt.Printf("- **State**: %s\n", f.State.Name)
+			}
 			if f.Assignee != nil {
-				assignee = f.Assignee.Name
+				fmt.Printf("- **Assignee**: %s\n", f.Assignee.Name)
+			} else {
+				fmt.Printf("- **Assignee**: Unassigned\n")
 			}
-
-			team := ""
 			if f.Team != nil {
-				team = f.Team.Key
+				fmt.Printf("- **Team**: %s\n", f.Team.Key)
 			}
-
-			state := ""
-			if f.State != nil {
-				state = f.State.Name
+			fmt.Printf("- **Created**: %s\n", f.CreatedAt.Format("2006-01-02"))
+			fmt.Printf("- **URL**: %s\n", f.Url)
+			if f.Description != nil && *f.Description != "" {
+				fmt.Printf("- **Description**: %s\n", *f.Description)
 			}
+			fmt.Println()
+		}
+		return
+	}
 
-			rows[i] = []string{
-				truncateString(f.Title, 50),
-				state,
-				assignee,
-				team,
-				f.CreatedAt.Format("2006-01-02"),
-				f.Url,
-			}
+	// Table output
+	headers := []string{"Title", "State", "Assignee", "Team", "Created", "URL"}
+	if prettyPriority {
+		headers = []string{"Title", "State", "Priority", "Assignee", "Team", "Created", "URL"}
+	}
+	rows := make([][]string, len(nodes))
+
+	for i, node := range nodes {
+		f := node.IssueListFields
+
+		assignee := "Unassigned"
+		if f.Assignee != nil {
+			assignee = f.Assignee.Name
 		}
 
-		tableData := output.TableData{
-			Headers: headers,
-			Rows:    rows,
+		team := ""
+		if f.Team != nil {
+			team = f.Team.Key
 		}
 
-		output.Table(tableData, false, false)
-		fmt.Printf("\nTotal: %d issues\n", len(resp.Issues.Nodes))
-	},
+		state := ""
+		if f.State != nil {
+			state = f.State.Name
+		}
+
+		row := []string{truncateString(f.Title, 50), state}
+		if prettyPriority {
+			row = append(row, formatPriority(int(f.Priority), true))
+		}
+		rows[i] = append(row,
+			assignee,
+			team,
+			f.CreatedAt.Format("2006-01-02"),
+			f.Url,
+		)
+	}
+
+	tableData := output.TableData{
+		Headers: headers,
+		Rows:    rows,
+	}
+
+	output.Table(tableData, false, false)
 }
 
 var issueSearchCmd = &cobra.Command{
@@ -202,6 +328,22 @@ Examples:
 
 		// Build typed filter from flags
 		filterTyped := buildIssueFilterTyped(cmd)
+		printCreatedWindow(cmd, filterTyped)
+		if err := validateStateFilter(cmd.Context(), client, cmd, filterTyped); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if cycle, _ := cmd.Flags().GetString("cycle"); cycle != "" {
+			teamKey, _ := cmd.Flags().GetString("team")
+			if _, negate := negated(teamKey); negate {
+				teamKey = ""
+			}
+			filterTyped.Cycle, err = cycleFilter(cmd.Context(), client, teamKey, cycle)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
@@ -237,15 +379,31 @@ Examples:
 		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 		includeArchivedPtr := &includeArchived
 
-		resp, err := api.SearchIssues(context.Background(), client, query, filterTyped, limitPtr, nil, orderByEnum, includeArchivedPtr)
+		resp, err := api.SearchIssues(cmd.Context(), client, query, filterTyped, limitPtr, nil, orderByEnum, includeArchivedPtr)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to search issues: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 
+		olderIssuesExist := func(older *api.IssueFilter) (bool, error) {
+			first := 1
+			resp, err := api.SearchIssues(cmd.Context(), client, query, older, &first, nil, nil, includeArchivedPtr)
+			if err != nil {
+				return false, err
+			}
+			return len(resp.SearchIssues.Nodes) > 0, nil
+		}
+
+		if summaryOnly() {
+			printTotal(len(resp.SearchIssues.Nodes), "search results")
+			printDefaultWindowNote(cmd, filterTyped, resp.SearchIssues.PageInfo.HasNextPage, olderIssuesExist)
+			return
+		}
+
 		// Check if empty
 		if len(resp.SearchIssues.Nodes) == 0 {
 			output.Info(fmt.Sprintf("No matches found for %q", query), plaintext, jsonOut)
+			printDefaultWindowNote(cmd, filterTyped, false, olderIssuesExist)
 			return
 		}
 
@@ -279,12 +437,17 @@ Examples:
 				}
 				fmt.Println()
 			}
-			fmt.Printf("\nTotal: %d search results\n", len(resp.SearchIssues.Nodes))
+			printTotal(len(resp.SearchIssues.Nodes), "search results")
+			printDefaultWindowNote(cmd, filterTyped, resp.SearchIssues.PageInfo.HasNextPage, olderIssuesExist)
 			return
 		}
 
 		// Table output
+		prettyPriority, _ := cmd.Flags().GetBool("pretty-priority")
 		headers := []string{"Title", "State", "Assignee", "Team", "Created", "URL"}
+		if prettyPriority {
+			headers = []string{"Title", "State", "Priority", "Assignee", "Team", "Created", "URL"}
+		}
 		rows := make([][]string, len(resp.SearchIssues.Nodes))
 
 		for i, node := range resp.SearchIssues.Nodes {
@@ -303,14 +466,16 @@ Examples:
 				state = node.State.Name
 			}
 
-			rows[i] = []string{
-				truncateString(node.Title, 50),
-				state,
+			row := []string{truncateString(node.Title, 50), state}
+			if prettyPriority {
+				row = append(row, formatPriority(int(node.Priority), true))
+			}
+			rows[i] = append(row,
 				assignee,
 				team,
 				node.CreatedAt.Format("2006-01-02"),
 				node.Url,
-			}
+			)
 		}
 
 		tableData := output.TableData{
@@ -319,7 +484,8 @@ Examples:
 		}
 
 		output.Table(tableData, false, false)
-		fmt.Printf("\nTotal: %d search results\n", len(resp.SearchIssues.Nodes))
+		printTotal(len(resp.SearchIssues.Nodes), "search results")
+		printDefaultWindowNote(cmd, filterTyped, resp.SearchIssues.PageInfo.HasNextPage, olderIssuesExist)
 	},
 }
 
@@ -333,6 +499,12 @@ var issueGetCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'lincli auth' first.", plaintext, jsonOut)
@@ -340,14 +512,39 @@ var issueGetCmd = &cobra.Command{
 		}
 
 		client := api.NewClient(authHeader)
-		resp, err := api.GetIssue(context.Background(), client, args[0])
+		resp, err := api.GetIssue(cmd.Context(), client, issueID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 		issue := resp.Issue
 
+		// Optionally replace the bundled recent comments with the full thread
+		commentsAll, _ := cmd.Flags().GetBool("comments-all")
+		var allComments []*api.ListCommentsIssueCommentsCommentConnectionNodesComment
+		if commentsAll {
+			allComments, err = fetchAllComments(cmd.Context(), client, issue.IssueDetailFields.Id)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to fetch comments: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			sort.SliceStable(allComments, func(i, j int) bool {
+				return allComments[i].CreatedAt.Before(allComments[j].CreatedAt)
+			})
+			if len(allComments) > largeCommentThread && !jsonOut && !viper.GetBool("quiet") {
+				fmt.Fprintf(os.Stderr, "Warning: %s has %d comments; the full thread follows\n",
+					issue.IssueDetailFields.Identifier, len(allComments))
+			}
+		}
+
 		if jsonOut {
+			if commentsAll {
+				output.JSON(struct {
+					api.IssueDetailFields
+					Comments []*api.ListCommentsIssueCommentsCommentConnectionNodesComment `json:"comments"`
+				}{issue.IssueDetailFields, allComments})
+				return
+			}
 			output.JSON(issue.IssueDetailFields)
 			return
 		}
@@ -385,12 +582,16 @@ var issueGetCmd = &cobra.Command{
 					fmt.Printf("  - Description: %s\n", *issue.IssueDetailFields.Team.Description)
 				}
 			}
-			fmt.Printf("- **Priority**: %s (%.0f)\n", priorityToString(int(issue.IssueDetailFields.Priority)), int(issue.IssueDetailFields.Priority))
+			fmt.Printf("- **Priority**: %s (%d)\n", formatPriority(int(issue.IssueDetailFields.Priority), false), int(issue.IssueDetailFields.Priority))
 			if issue.IssueDetailFields.PriorityLabel != "" {
 				fmt.Printf("- **Priority Label**: %s\n", issue.IssueDetailFields.PriorityLabel)
 			}
 			if issue.IssueDetailFields.Estimate != nil {
-				fmt.Printf("- **Estimate**: %.1f\n", *issue.IssueDetailFields.Estimate)
+				estimationType := ""
+				if issue.IssueDetailFields.Team != nil {
+					estimationType = issue.IssueDetailFields.Team.IssueEstimationType
+				}
+				fmt.Printf("- **Estimate**: %s\n", formatEstimate(*issue.IssueDetailFields.Estimate, estimationType))
 			}
 
 			fmt.Printf("\n## Status & Dates\n")
@@ -424,7 +625,7 @@ var issueGetCmd = &cobra.Command{
 				fmt.Printf("- **Git Branch**: %s\n", issue.IssueDetailFields.BranchName)
 			}
 			if issue.IssueDetailFields.CustomerTicketCount > 0 {
-				fmt.Printf("- **Customer Ticket Count**: %d\n", issue.IssueDetailFields.CustomerTicketCount)
+				fmt.Printf("- **Customer Ticket Count**: %s\n", numberFormat().Count(issue.IssueDetailFields.CustomerTicketCount))
 			}
 			if len(issue.IssueDetailFields.PreviousIdentifiers) > 0 {
 				fmt.Printf("- **Previous Identifiers**: %s\n", strings.Join(issue.IssueDetailFields.PreviousIdentifiers, ", "))
@@ -433,7 +634,11 @@ var issueGetCmd = &cobra.Command{
 				fmt.Printf("- **Integration Source**: %s\n", *issue.IssueDetailFields.IntegrationSourceType)
 			}
 			if issue.IssueDetailFields.ExternalUserCreator != nil {
-				fmt.Printf("- **External Creator**: %s (%s)\n", issue.IssueDetailFields.ExternalUserCreator.Name, issue.IssueDetailFields.ExternalUserCreator.Email)
+				fmt.Printf("- **External Creator**: %s", issue.IssueDetailFields.ExternalUserCreator.Name)
+				if issue.IssueDetailFields.ExternalUserCreator.Email != nil {
+					fmt.Printf(" (%s)", *issue.IssueDetailFields.ExternalUserCreator.Email)
+				}
+				fmt.Println()
 			}
 			fmt.Printf("- **URL**: %s\n", issue.IssueDetailFields.Url)
 
@@ -442,7 +647,7 @@ var issueGetCmd = &cobra.Command{
 				fmt.Printf("\n## Project\n")
 				fmt.Printf("- **Name**: %s\n", issue.IssueDetailFields.Project.Name)
 				fmt.Printf("- **State**: %s\n", issue.IssueDetailFields.Project.State)
-				fmt.Printf("- **Progress**: %.0f%%\n", issue.IssueDetailFields.Project.Progress*100)
+				fmt.Printf("- **Progress**: %s\n", numberFormat().Percent(issue.IssueDetailFields.Project.Progress))
 				if issue.IssueDetailFields.Project.Health != nil {
 					fmt.Printf("- **Health**: %s\n", *issue.IssueDetailFields.Project.Health)
 				}
@@ -453,12 +658,16 @@ var issueGetCmd = &cobra.Command{
 
 			if issue.IssueDetailFields.Cycle != nil {
 				fmt.Printf("\n## Cycle\n")
-				fmt.Printf("- **Name**: %s (#%.0f)\n", issue.IssueDetailFields.Cycle.Name, issue.IssueDetailFields.Cycle.Number)
+				cycleName := ""
+				if issue.IssueDetailFields.Cycle.Name != nil {
+					cycleName = *issue.IssueDetailFields.Cycle.Name
+				}
+				fmt.Printf("- **Name**: %s (#%.0f)\n", cycleName, issue.IssueDetailFields.Cycle.Number)
 				if issue.IssueDetailFields.Cycle.Description != nil && *issue.IssueDetailFields.Cycle.Description != "" {
 					fmt.Printf("- **Description**: %s\n", *issue.IssueDetailFields.Cycle.Description)
 				}
 				fmt.Printf("- **Period**: %s to %s\n", issue.IssueDetailFields.Cycle.StartsAt, issue.IssueDetailFields.Cycle.EndsAt)
-				fmt.Printf("- **Progress**: %.0f%%\n", issue.IssueDetailFields.Cycle.Progress*100)
+				fmt.Printf("- **Progress**: %s\n", numberFormat().Percent(issue.IssueDetailFields.Cycle.Progress))
 				if issue.IssueDetailFields.Cycle.CompletedAt != nil {
 					fmt.Printf("- **Completed**: %s\n", issue.IssueDetailFields.Cycle.CompletedAt.Format("2006-01-02"))
 				}
@@ -531,21 +740,11 @@ var issueGetCmd = &cobra.Command{
 			if issue.IssueDetailFields.Children != nil && len(issue.IssueDetailFields.Children.Nodes) > 0 {
 				fmt.Printf("\n## Sub-issues\n")
 				for _, child := range issue.IssueDetailFields.Children.Nodes {
-					stateStr := ""
+					stateType := ""
 					if child.State != nil {
-						switch child.State.Type {
-						case "completed", "done":
-							stateStr = "[x]"
-						case "started", "in_progress":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						default:
-							stateStr = "[ ]"
-						}
-					} else {
-						stateStr = "[ ]"
+						stateType = child.State.Type
 					}
+					stateStr := formatStateGlyph(stateType, true)
 
 					assignee := "Unassigned"
 					if child.Assignee != nil {
@@ -564,8 +763,13 @@ var issueGetCmd = &cobra.Command{
 				}
 			}
 
-			// Show recent comments if any
-			if issue.IssueDetailFields.Comments != nil && len(issue.IssueDetailFields.Comments.Nodes) > 0 {
+			// Show the full comment thread when requested, otherwise recent comments
+			if commentsAll {
+				if len(allComments) > 0 {
+					fmt.Printf("\n## Comments (%s)\n", numberFormat().Count(len(allComments)))
+					printCommentThread(allComments, true)
+				}
+			} else if issue.IssueDetailFields.Comments != nil && len(issue.IssueDetailFields.Comments.Nodes) > 0 {
 				fmt.Printf("\n## Recent Comments\n")
 				for _, comment := range issue.IssueDetailFields.Comments.Nodes {
 					userName := "Unknown"
@@ -587,7 +791,7 @@ var issueGetCmd = &cobra.Command{
 						}
 					}
 				}
-				fmt.Printf("\n> Use `lincli comment list %s` to see all comments\n", issue.IssueDetailFields.Identifier)
+				fmt.Printf("\n> Use `lincli issue get %s --comments-all` to see all comments\n", issue.IssueDetailFields.Identifier)
 			}
 
 			// Show history
@@ -608,13 +812,13 @@ var issueGetCmd = &cobra.Command{
 						changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
 					}
 					if entry.FromPriority != nil && entry.ToPriority != nil {
-						changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(int(*entry.FromPriority)), priorityToString(int(*entry.ToPriority))))
+						changes = append(changes, fmt.Sprintf("Priority: %s → %s", formatPriority(int(*entry.FromPriority), false), formatPriority(int(*entry.ToPriority), false)))
 					}
 					if entry.FromTitle != nil && entry.ToTitle != nil {
 						changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
 					}
-					if entry.FromCycle != nil && entry.ToCycle != nil {
-						changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
+					if entry.FromCycle != nil && entry.ToCycle != nil && entry.FromCycle.Name != nil && entry.ToCycle.Name != nil {
+						changes = append(changes, fmt.Sprintf("Cycle: %s → %s", *entry.FromCycle.Name, *entry.ToCycle.Name))
 					}
 					if entry.FromProject != nil && entry.ToProject != nil {
 						changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
@@ -669,18 +873,30 @@ var issueGetCmd = &cobra.Command{
 				color.New(color.FgMagenta).Sprint(issue.IssueDetailFields.Team.Name))
 		}
 
-		fmt.Printf("Priority: %s\n", priorityToString(int(issue.IssueDetailFields.Priority)))
+		fmt.Printf("Priority: %s\n", formatPriority(int(issue.IssueDetailFields.Priority), true))
+
+		if issue.IssueDetailFields.Estimate != nil {
+			estimationType := ""
+			if issue.IssueDetailFields.Team != nil {
+				estimationType = issue.IssueDetailFields.Team.IssueEstimationType
+			}
+			fmt.Printf("Estimate: %s\n", formatEstimate(*issue.IssueDetailFields.Estimate, estimationType))
+		}
 
 		// Show project and cycle info
 		if issue.IssueDetailFields.Project != nil {
 			fmt.Printf("Project: %s (%s)\n",
 				color.New(color.FgBlue).Sprint(issue.IssueDetailFields.Project.Name),
-				color.New(color.FgWhite, color.Faint).Sprintf("%.0f%%", issue.IssueDetailFields.Project.Progress*100))
+				color.New(color.FgWhite, color.Faint).Sprint(numberFormat().Percent(issue.IssueDetailFields.Project.Progress)))
 		}
 
 		if issue.IssueDetailFields.Cycle != nil {
+			cycleName := fmt.Sprintf("#%.0f", issue.IssueDetailFields.Cycle.Number)
+			if issue.IssueDetailFields.Cycle.Name != nil && *issue.IssueDetailFields.Cycle.Name != "" {
+				cycleName = *issue.IssueDetailFields.Cycle.Name
+			}
 			fmt.Printf("Cycle: %s\n",
-				color.New(color.FgMagenta).Sprint(issue.IssueDetailFields.Cycle.Name))
+				color.New(color.FgMagenta).Sprint(cycleName))
 		}
 
 		fmt.Printf("Created: %s\n", issue.IssueDetailFields.CreatedAt.Format("2006-01-02 15:04:05"))
@@ -720,17 +936,11 @@ var issueGetCmd = &cobra.Command{
 		if issue.IssueDetailFields.Children != nil && len(issue.IssueDetailFields.Children.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Sub-issues:"))
 			for _, child := range issue.IssueDetailFields.Children.Nodes {
-				stateIcon := "○"
+				stateType := ""
 				if child.State != nil {
-					switch child.State.Type {
-					case "completed", "done":
-						stateIcon = color.New(color.FgGreen).Sprint("✓")
-					case "started", "in_progress":
-						stateIcon = color.New(color.FgBlue).Sprint("◐")
-					case "canceled":
-						stateIcon = color.New(color.FgRed).Sprint("✗")
-					}
+					stateType = child.State.Type
 				}
+				stateIcon := formatStateGlyph(stateType, false)
 
 				assignee := "Unassigned"
 				if child.Assignee != nil {
@@ -755,8 +965,13 @@ var issueGetCmd = &cobra.Command{
 			}
 		}
 
-		// Show recent comments if any
-		if issue.IssueDetailFields.Comments != nil && len(issue.IssueDetailFields.Comments.Nodes) > 0 {
+		// Show the full comment thread when requested, otherwise recent comments
+		if commentsAll {
+			if len(allComments) > 0 {
+				fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprintf("Comments (%s):", numberFormat().Count(len(allComments))))
+				printCommentThread(allComments, false)
+			}
+		} else if issue.IssueDetailFields.Comments != nil && len(issue.IssueDetailFields.Comments.Nodes) > 0 {
 			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Recent Comments:"))
 			for _, comment := range issue.IssueDetailFields.Comments.Nodes {
 				userName := "Unknown"
@@ -776,7 +991,7 @@ var issueGetCmd = &cobra.Command{
 					fmt.Printf("     %s\n", preview)
 				}
 			}
-			fmt.Printf("\n  %s Use 'lincli comment list %s' to see all comments\n",
+			fmt.Printf("\n  %s Use 'lincli issue get %s --comments-all' to see all comments\n",
 				color.New(color.FgWhite, color.Faint).Sprint("→"),
 				issue.IssueDetailFields.Identifier)
 		}
@@ -784,6 +999,67 @@ var issueGetCmd = &cobra.Command{
 }
 
 
+// largeCommentThread is the comment count above which --comments-all warns
+const largeCommentThread = 100
+
+// printCommentThread renders comments with replies nested under their parent.
+// Comments must already be in display order.
+func printCommentThread(comments []*api.ListCommentsIssueCommentsCommentConnectionNodesComment, plaintext bool) {
+	replies := make(map[string][]*api.ListCommentsIssueCommentsCommentConnectionNodesComment)
+	ids := make(map[string]bool, len(comments))
+	for _, comment := range comments {
+		ids[comment.Id] = true
+	}
+	var roots []*api.ListCommentsIssueCommentsCommentConnectionNodesComment
+	for _, comment := range comments {
+		// Replies whose parent isn't in the thread are shown at the top level
+		if comment.Parent != nil && ids[comment.Parent.Id] {
+			replies[comment.Parent.Id] = append(replies[comment.Parent.Id], comment)
+		} else {
+			roots = append(roots, comment)
+		}
+	}
+
+	var printComment func(comment *api.ListCommentsIssueCommentsCommentConnectionNodesComment, depth int)
+	printComment = func(comment *api.ListCommentsIssueCommentsCommentConnectionNodesComment, depth int) {
+		userName := "Unknown"
+		if comment.User != nil {
+			userName = comment.User.Name
+		}
+		indent := strings.Repeat("  ", depth)
+
+		if plaintext {
+			if depth == 0 {
+				fmt.Printf("\n### %s - %s\n", userName, comment.CreatedAt.Format("2006-01-02 15:04"))
+			} else {
+				fmt.Printf("\n%s**Reply from %s** - %s\n", indent, userName, comment.CreatedAt.Format("2006-01-02 15:04"))
+			}
+			if comment.EditedAt != nil {
+				fmt.Printf("%s*(edited %s)*\n", indent, comment.EditedAt.Format("2006-01-02 15:04"))
+			}
+			for _, line := range strings.Split(comment.Body, "\n") {
+				fmt.Printf("%s%s\n", indent, line)
+			}
+		} else {
+			fmt.Printf("\n  %s💬 %s - %s\n",
+				indent,
+				color.New(color.FgCyan).Sprint(userName),
+				color.New(color.FgWhite, color.Faint).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")))
+			for _, line := range strings.Split(comment.Body, "\n") {
+				fmt.Printf("     %s%s\n", indent, line)
+			}
+		}
+
+		for _, reply := range replies[comment.Id] {
+			printComment(reply, depth+1)
+		}
+	}
+
+	for _, comment := range roots {
+		printComment(comment, 0)
+	}
+}
+
 func priorityToString(priority int) string {
 	switch priority {
 	case 0:
@@ -801,6 +1077,130 @@ func priorityToString(priority int) string {
 	}
 }
 
+// priorityIcons maps Linear priority values to their display icon
+var priorityIcons = map[int]string{
+	0: "∅",
+	1: "🔴",
+	2: "🟠",
+	3: "🟡",
+	4: "⚪",
+}
+
+// priorityColors maps Linear priority values to the color used for their label
+var priorityColors = map[int]*color.Color{
+	0: color.New(color.FgWhite, color.Faint),
+	1: color.New(color.FgRed, color.Bold),
+	2: color.New(color.FgRed),
+	3: color.New(color.FgYellow),
+	4: color.New(color.FgWhite),
+}
+
+// formatPriority renders a priority for display. With withIcon set the label
+// is prefixed with its icon and colored; otherwise the plain label is returned.
+func formatPriority(priority int, withIcon bool) string {
+	label := priorityToString(priority)
+	if !withIcon {
+		return label
+	}
+	if c, ok := priorityColors[priority]; ok {
+		label = c.Sprint(label)
+	}
+	if icon, ok := priorityIcons[priority]; ok && !asciiGlyphs() {
+		return icon + " " + label
+	}
+	return label
+}
+
+// asciiGlyphs reports whether icons should be replaced by ASCII (--ascii or --no-emoji)
+func asciiGlyphs() bool {
+	return viper.GetBool("ascii") || viper.GetBool("no-emoji")
+}
+
+// stateGlyph is how one state type is marked in issue lists: an ASCII
+// checkbox and a rich icon with its color
+type stateGlyph struct {
+	checkbox string
+	icon     string
+	color    *color.Color
+}
+
+// stateGlyphs maps normalized state types to their glyphs
+var stateGlyphs = map[string]stateGlyph{
+	"completed": {checkbox: "[x]", icon: "✓", color: color.New(color.FgGreen)},
+	"started":   {checkbox: "[~]", icon: "◐", color: color.New(color.FgBlue)},
+	"canceled":  {checkbox: "[-]", icon: "✗", color: color.New(color.FgRed)},
+	"unstarted": {checkbox: "[ ]", icon: "○"},
+}
+
+// normalizeStateType folds state type aliases onto the stateGlyphs keys.
+// Triage, backlog, unknown, and missing states all count as unstarted.
+func normalizeStateType(stateType string) string {
+	switch strings.ToLower(stateType) {
+	case "completed", "done":
+		return "completed"
+	case "started", "in_progress":
+		return "started"
+	case "canceled", "cancelled":
+		return "canceled"
+	default:
+		return "unstarted"
+	}
+}
+
+// formatStateGlyph returns the status marker for an issue's state type.
+// Plaintext uses checkboxes; rich output uses colored icons, or colored
+// checkboxes under --ascii/--no-emoji.
+func formatStateGlyph(stateType string, plaintext bool) string {
+	glyph := stateGlyphs[normalizeStateType(stateType)]
+	if plaintext {
+		return glyph.checkbox
+	}
+
+	marker := glyph.icon
+	if asciiGlyphs() {
+		marker = glyph.checkbox
+	}
+	if glyph.color != nil {
+		return glyph.color.Sprint(marker)
+	}
+	return marker
+}
+
+// estimationScaleNames maps a team's issueEstimationType to a display name
+var estimationScaleNames = map[string]string{
+	"exponential": "Exponential",
+	"fibonacci":   "Fibonacci",
+	"linear":      "Linear",
+	"tShirt":      "T-shirt",
+}
+
+// tShirtSizes maps point values on Linear's t-shirt scale to their size label
+var tShirtSizes = map[float64]string{
+	1:  "XS",
+	2:  "S",
+	3:  "M",
+	5:  "L",
+	8:  "XL",
+	13: "XXL",
+	21: "XXXL",
+}
+
+// formatEstimate renders an estimate with the team's estimation scale, e.g.
+// "3 (Fibonacci)" or "M (T-shirt, 3)". Unknown scales fall back to the number.
+func formatEstimate(estimate float64, estimationType string) string {
+	value := numberFormat().Decimal(estimate)
+	scale, ok := estimationScaleNames[estimationType]
+	if !ok {
+		return value
+	}
+	if estimationType == "tShirt" {
+		if size, ok := tShirtSizes[estimate]; ok {
+			return fmt.Sprintf("%s (%s, %s)", size, scale, value)
+		}
+	}
+	return fmt.Sprintf("%s (%s)", value, scale)
+}
+
 func truncateString(s string, maxLen int) string {
 	if len(s) <= maxLen {
 		return s
@@ -850,6 +1250,74 @@ func buildIssueUpdateInput(cmd *cobra.Command) api.IssueUpdateInput {
 	return input
 }
 
+// resolveUserID resolves "me", an email address, a user or display name, or
+// a user ID to a user ID
+func resolveUserID(ctx context.Context, client *api.Client, user string) (string, error) {
+	if user == "me" {
+		viewerResp, err := api.GetViewer(ctx, client)
+		if err != nil {
+			return "", fmt.Errorf("failed to get current user: %w", err)
+		}
+		return viewerResp.Viewer.UserDetailFields.Id, nil
+	}
+
+	// Look up user by email or name using generated function
+	filter := &api.UserFilter{
+		Or: []*api.UserFilter{
+			{Email: &api.StringComparator{Eq: &user}},
+			{Name: &api.StringComparator{Eq: &user}},
+			{DisplayName: &api.StringComparator{Eq: &user}},
+		},
+	}
+	if issueUUIDPattern.MatchString(user) {
+		filter.Or = append(filter.Or, &api.UserFilter{Id: &api.IDComparator{Eq: &user}})
+	}
+
+	userResp, err := api.GetUserByEmail(ctx, client, filter)
+	if err != nil {
+		return "", fmt.Errorf("failed to find user: %w", err)
+	}
+
+	if len(userResp.Users.Nodes) == 0 {
+		return "", fmt.Errorf("user not found: %s", user)
+	}
+
+	return userResp.Users.Nodes[0].UserDetailFields.Id, nil
+}
+
+// createAssignee determines who a new issue should be assigned to.
+// Precedence: --no-assignee, --assignee, --assign-me, then the
+// default_assignee and create_assign_me config keys. Returns an empty
+// string when the issue should be left unassigned.
+func createAssignee(cmd *cobra.Command) string {
+	if noAssignee, _ := cmd.Flags().GetBool("no-assignee"); noAssignee {
+		return ""
+	}
+
+	if cmd.Flags().Changed("assignee") {
+		assignee, _ := cmd.Flags().GetString("assignee")
+		if assignee == "unassigned" {
+			return ""
+		}
+		return assignee
+	}
+
+	if assignToMe, _ := cmd.Flags().GetBool("assign-me"); assignToMe {
+		return "me"
+	}
+
+	// An explicit default assignee wins over the create_assign_me shortcut
+	if defaultAssignee := viper.GetString("default_assignee"); defaultAssignee != "" {
+		return defaultAssignee
+	}
+
+	if viper.GetBool("create_assign_me") {
+		return "me"
+	}
+
+	return ""
+}
+
 var issueAssignCmd = &cobra.Command{
 	Use:   "assign [issue-id]",
 	Short: "Assign issue to yourself",
@@ -859,6 +1327,12 @@ var issueAssignCmd = &cobra.Command{
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'lincli auth' first.", plaintext, jsonOut)
@@ -868,7 +1342,7 @@ var issueAssignCmd = &cobra.Command{
 		client := api.NewClient(authHeader)
 
 		// Get current user
-		viewerResp, err := api.GetViewer(context.Background(), client)
+		viewerResp, err := api.GetViewer(cmd.Context(), client)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -880,7 +1354,7 @@ var issueAssignCmd = &cobra.Command{
 			AssigneeId: &viewerID,
 		}
 
-		updateResp, err := api.UpdateIssue(context.Background(), client, args[0], &input)
+		updateResp, err := api.UpdateIssue(cmd.Context(), client, issueID, &input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to assign issue: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -905,7 +1379,24 @@ var issueCreateCmd = &cobra.Command{
 	Use:     "create",
 	Aliases: []string{"new"},
 	Short:   "Create a new issue",
-	Long:    `Create a new issue in Linear.`,
+	Long: `Create a new issue in Linear.
+
+The assignee is taken from --assignee, --assign-me, or --no-assignee when given.
+Otherwise the default_assignee config key is used, followed by create_assign_me
+(assign every new issue to yourself).
+
+--input-file loads the whole issue from a JSON object with the keys title,
+description, teamKey, assignee (or assigneeEmail), state, priority, estimate,
+dueDate, and labels (a list of label names). Flags override values from the
+file, and unknown keys are rejected.
+
+Examples:
+  lincli issue create --title "Bug fix" --team ENG
+  lincli issue create --title "Bug fix" --team ENG --assign-me
+  lincli issue create --title "Bug fix" --team ENG --assignee jane@company.com
+  lincli issue create --title "Bug fix" --team ENG --no-assignee
+  lincli issue create --input-file issue.json
+  lincli issue create --input-file issue.json --team OPS`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -918,23 +1409,27 @@ var issueCreateCmd = &cobra.Command{
 
 		client := api.NewClient(authHeader)
 
-		// Get flags
-		title, _ := cmd.Flags().GetString("title")
-		teamKey, _ := cmd.Flags().GetString("team")
-		assignToMe, _ := cmd.Flags().GetBool("assign-me")
-
-		if title == "" {
-			output.Error("Title is required (--title)", plaintext, jsonOut)
+		// Load the input file first; flags given on the command line override it
+		file, err := readIssueInputFile(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
 			os.Exit(1)
 		}
+		if file != nil {
+			if err := file.applyToFlags(cmd); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
 
-		if teamKey == "" {
-			output.Error("Team is required (--team)", plaintext, jsonOut)
+		if err := checkCreateRequired(cmd); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
 			os.Exit(1)
 		}
+		teamKey, _ := cmd.Flags().GetString("team")
 
 		// Get team ID from key
-		teamResp, err := api.GetTeam(context.Background(), client, teamKey)
+		teamResp, err := api.GetTeam(cmd.Context(), client, teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
 			os.Exit(1)
@@ -944,18 +1439,57 @@ var issueCreateCmd = &cobra.Command{
 		// Build input
 		input := buildIssueCreateInput(cmd, team.TeamDetailFields.Id)
 
-		if assignToMe {
-			viewerResp, err := api.GetViewer(context.Background(), client)
+		// Resolve assignee from flags, falling back to config defaults
+		if assignee := createAssignee(cmd); assignee != "" {
+			userID, err := resolveUserID(cmd.Context(), client, assignee)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
+				output.Error(fmt.Sprintf("Failed to resolve assignee: %v", err), plaintext, jsonOut)
 				os.Exit(1)
 			}
-			viewerID := viewerResp.Viewer.UserDetailFields.Id
-			input.AssigneeId = &viewerID
+			input.AssigneeId = &userID
+		}
+
+		// Input file keys that have no create flag
+		if file != nil {
+			if file.State != nil {
+				statesResp, err := api.GetTeamStates(cmd.Context(), client, teamKey)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to get workflow states: %v", err), plaintext, jsonOut)
+					os.Exit(1)
+				}
+				var states []*api.GetTeamStatesTeamStatesWorkflowStateConnectionNodesWorkflowState
+				if statesResp.Team != nil && statesResp.Team.States != nil {
+					states = statesResp.Team.States.Nodes
+				}
+				state, _ := resolveWorkflowState(states, *file.State)
+				if state == nil {
+					var stateNames []string
+					for _, state := range states {
+						stateNames = append(stateNames, state.Name)
+					}
+					output.Error(fmt.Sprintf("State '%s' not found in team '%s'. Available states: %s", *file.State, teamKey, strings.Join(stateNames, ", ")), plaintext, jsonOut)
+					os.Exit(1)
+				}
+				input.StateId = &state.Id
+			}
+
+			if file.DueDate != nil && *file.DueDate != "" {
+				input.DueDate = file.DueDate
+			}
+			input.Estimate = file.Estimate
+
+			if file.Labels != nil {
+				labelIDs, err := resolveLabelIDs(cmd.Context(), client, team.TeamDetailFields.Id, *file.Labels)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to resolve labels: %v", err), plaintext, jsonOut)
+					os.Exit(1)
+				}
+				input.LabelIds = labelIDs
+			}
 		}
 
 		// Create issue
-		createResp, err := api.CreateIssue(context.Background(), client, &input)
+		createResp, err := api.CreateIssue(cmd.Context(), client, &input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -985,19 +1519,35 @@ var issueUpdateCmd = &cobra.Command{
 	Short: "Update an issue",
 	Long: `Update various fields of an issue.
 
+--input-file applies a JSON object with any of the keys title, description,
+assignee (or assigneeEmail), state, priority, estimate, dueDate, and labels.
+Labels replace the issue's current labels. Flags override values from the
+file, and unknown keys are rejected.
+
 Examples:
   lincli issue update LIN-123 --title "New title"
   lincli issue update LIN-123 --description "Updated description"
   lincli issue update LIN-123 --assignee john.doe@company.com
   lincli issue update LIN-123 --state "In Progress"
+  lincli issue update LIN-123 --state started  # Team's first "started" state if none is named that
   lincli issue update LIN-123 --priority 1
   lincli issue update LIN-123 --due-date "2024-12-31"
-  lincli issue update LIN-123 --title "New title" --assignee me --priority 2`,
+  lincli issue update LIN-123 --title "New title" --assignee me --priority 2
+  lincli issue update LIN-123 --state "Blocked" --comment "Waiting on API access"
+  git log -1 --format=%B | lincli issue update LIN-123 --state Done --comment -
+  lincli issue update LIN-123 --input-file patch.json
+  lincli issue update LIN-123 --input-file patch.json --priority 1`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
+		issueID, err := resolveIssueIdentifier(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Invalid issue identifier: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'lincli auth' first.", plaintext, jsonOut)
@@ -1006,87 +1556,90 @@ Examples:
 
 		client := api.NewClient(authHeader)
 
+		// Load the input file first; flags given on the command line override it
+		file, err := readIssueInputFile(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if file != nil {
+			if file.TeamKey != nil {
+				output.Error("teamKey is only supported by 'issue create'; issues cannot be moved between teams with --input-file", plaintext, jsonOut)
+				os.Exit(1)
+			}
+			if err := file.applyToFlags(cmd); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
 		// Build update input using builder function
 		input := buildIssueUpdateInput(cmd)
+		if file != nil {
+			input.Estimate = file.Estimate
+		}
 
 		// Handle assignee update
 		if cmd.Flags().Changed("assignee") {
 			assignee, _ := cmd.Flags().GetString("assignee")
 			switch assignee {
-			case "me":
-				// Get current user
-				viewerResp, err := api.GetViewer(context.Background(), client)
-				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				viewerID := viewerResp.Viewer.UserDetailFields.Id
-				input.AssigneeId = &viewerID
 			case "unassigned", "":
 				// Set to nil to unassign
 				var nilID *string
 				input.AssigneeId = nilID
 			default:
-				// Look up user by email or name using generated function
-				filter := &api.UserFilter{
-					Or: []*api.UserFilter{
-						{Email: &api.StringComparator{Eq: &assignee}},
-						{Name: &api.StringComparator{Eq: &assignee}},
-					},
-				}
-
-				userResp, err := api.GetUserByEmail(context.Background(), client, filter)
+				userID, err := resolveUserID(cmd.Context(), client, assignee)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to find user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				if len(userResp.Users.Nodes) == 0 {
-					output.Error(fmt.Sprintf("User not found: %s", assignee), plaintext, jsonOut)
+					output.Error(fmt.Sprintf("Failed to resolve assignee: %v", err), plaintext, jsonOut)
 					os.Exit(1)
 				}
-
-				userID := userResp.Users.Nodes[0].UserDetailFields.Id
 				input.AssigneeId = &userID
 			}
 		}
 
-		// Handle state update - uses embedded workflow states from GetIssue
-		if cmd.Flags().Changed("state") {
-			stateName, _ := cmd.Flags().GetString("state")
-
-			// Get the issue to access embedded team workflow states (no extra API call)
-			issueResp, err := api.GetIssue(context.Background(), client, args[0])
+		// State and labels are both resolved against the issue's team
+		var issue *api.GetIssueIssue
+		if cmd.Flags().Changed("state") || (file != nil && file.Labels != nil) {
+			issueResp, err := api.GetIssue(cmd.Context(), client, issueID)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
 				os.Exit(1)
 			}
-			issue := issueResp.Issue
+			issue = issueResp.Issue
+		}
+
+		// Labels from the input file replace the issue's current labels
+		if file != nil && file.Labels != nil {
+			labelIDs, err := resolveLabelIDs(cmd.Context(), client, issue.IssueDetailFields.Team.Id, *file.Labels)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve labels: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			input.LabelIds = labelIDs
+		}
+
+		// Handle state update - uses embedded workflow states from GetIssue
+		var chosenState *resolvedState
+		if cmd.Flags().Changed("state") {
+			stateName, _ := cmd.Flags().GetString("state")
 
 			// States are embedded in issue response (issue.Team.States.Nodes)
 			states := issue.IssueDetailFields.Team.States.Nodes
 
-			// Find the state by name (case-insensitive)
-			var stateID string
-			for _, state := range states {
-				if strings.EqualFold(state.Name, stateName) {
-					stateID = state.Id
-					break
-				}
-			}
-
-			if stateID == "" {
+			state, byType := resolveWorkflowState(states, stateName)
+			if state == nil {
 				// Show available states
 				var stateNames []string
 				for _, state := range states {
 					stateNames = append(stateNames, state.Name)
 				}
 				teamKey := issue.IssueDetailFields.Team.Key
-				output.Error(fmt.Sprintf("State '%s' not found in team '%s'. Available states: %s", stateName, teamKey, strings.Join(stateNames, ", ")), plaintext, jsonOut)
+				output.Error(fmt.Sprintf("State '%s' not found in team '%s'. Available states: %s (or a state type: triage, backlog, unstarted, started, completed, canceled)", stateName, teamKey, strings.Join(stateNames, ", ")), plaintext, jsonOut)
 				os.Exit(1)
 			}
 
-			input.StateId = &stateID
+			input.StateId = &state.Id
+			chosenState = &resolvedState{ID: state.Id, Name: state.Name, Type: state.Type, MatchedByType: byType}
 		}
 
 		// Handle due date update
@@ -1100,34 +1653,617 @@ Examples:
 			}
 		}
 
+		// Read the optional comment up front so a bad file or empty stdin
+		// fails before anything is changed
+		commentBody, err := readCommentFlag(cmd)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
 		// Check if any updates were specified (check all pointer fields)
 		hasUpdates := input.Title != nil ||
 			input.Description != nil ||
 			input.Priority != nil ||
 			input.AssigneeId != nil ||
 			input.StateId != nil ||
-			input.DueDate != nil
+			input.DueDate != nil ||
+			input.Estimate != nil ||
+			input.LabelIds != nil
 
-		if !hasUpdates {
-			output.Error("No updates specified. Use flags to specify what to update.", plaintext, jsonOut)
+		if !hasUpdates && commentBody == "" {
+			output.Error("No updates specified. Use flags or --input-file to specify what to update.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-		// Update the issue using generated function
-		updateResp, err := api.UpdateIssue(context.Background(), client, args[0], &input)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
+		var updatedIssue *api.UpdateIssueIssueUpdateIssuePayloadIssue
+		if hasUpdates {
+			// Update the issue using generated function
+			updateResp, err := api.UpdateIssue(cmd.Context(), client, issueID, &input)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			updatedIssue = updateResp.IssueUpdate.Issue
+			issueID = updatedIssue.IssueListFields.Identifier
+		}
+
+		if commentBody == "" {
+			if jsonOut {
+				output.JSON(issueJSONWithState(updatedIssue, chosenState))
+			} else if plaintext {
+				fmt.Printf("Updated issue %s%s\n", updatedIssue.IssueListFields.Identifier, chosenState.note())
+			} else {
+				output.Success(fmt.Sprintf("Updated issue %s%s", updatedIssue.IssueListFields.Identifier, chosenState.note()), plaintext, jsonOut)
+			}
+			return
+		}
+
+		// Post the comment after the field changes have been applied
+		commentInput := &api.CommentCreateInput{
+			Body:    &commentBody,
+			IssueId: &issueID,
+		}
+		commentResp, commentErr := api.CreateComment(cmd.Context(), client, commentInput)
+
+		var comment *api.CreateCommentCommentCreateCommentPayloadComment
+		if commentErr == nil {
+			comment = commentResp.CommentCreate.Comment
+		}
+		if !reportUpdateWithComment(issueID, updatedIssue, chosenState, comment, commentErr, plaintext, jsonOut) {
 			os.Exit(1)
 		}
-		updatedIssue := updateResp.IssueUpdate.Issue
+	},
+}
 
-		if jsonOut {
-			output.JSON(updatedIssue)
-		} else if plaintext {
-			fmt.Printf("Updated issue %s\n", updatedIssue.IssueListFields.Identifier)
+// reportUpdateWithComment reports the outcome of issue update --comment, where
+// the field update and the comment are separate API calls. Both outcomes are
+// always reported, and it returns false when the comment failed so the command
+// exits non-zero even though the field changes were applied.
+func reportUpdateWithComment(issueID string, updatedIssue *api.UpdateIssueIssueUpdateIssuePayloadIssue, state *resolvedState, comment *api.CreateCommentCommentCreateCommentPayloadComment, commentErr error, plaintext, jsonOut bool) bool {
+	if jsonOut {
+		result := map[string]interface{}{}
+		if updatedIssue != nil {
+			result["issue"] = updatedIssue
+		}
+		if state != nil {
+			result["resolvedState"] = state
+		}
+		if commentErr != nil {
+			result["commentError"] = commentErr.Error()
+		} else {
+			result["comment"] = comment
+		}
+		output.JSON(result)
+	} else {
+		if updatedIssue != nil {
+			output.Success(fmt.Sprintf("Updated issue %s%s", issueID, state.note()), plaintext, false)
+		}
+		if commentErr != nil {
+			output.Error(fmt.Sprintf("Failed to add comment to %s: %v", issueID, commentErr), plaintext, false)
 		} else {
-			output.Success(fmt.Sprintf("Updated issue %s", updatedIssue.IssueListFields.Identifier), plaintext, jsonOut)
+			output.Success(fmt.Sprintf("Added comment to %s", issueID), plaintext, false)
+		}
+	}
+
+	return commentErr == nil
+}
+
+// resolvedState is the workflow state chosen for issue update --state. It is
+// included in JSON output so scripts can see what a type keyword matched.
+type resolvedState struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	MatchedByType bool   `json:"matchedByType"`
+}
+
+// note returns the suffix for the "Updated issue" message, or "" when no
+// state was requested
+func (s *resolvedState) note() string {
+	if s == nil {
+		return ""
+	}
+	if s.MatchedByType {
+		return fmt.Sprintf(" (state: %s, matched by type %q)", s.Name, s.Type)
+	}
+	return fmt.Sprintf(" (state: %s)", s.Name)
+}
+
+// issueJSONWithState adds the resolved state to an updated issue's JSON
+// object under resolvedState, keeping the issue's own field order. The issue
+// is returned as is when no state was requested.
+func issueJSONWithState(issue *api.UpdateIssueIssueUpdateIssuePayloadIssue, state *resolvedState) interface{} {
+	if state == nil || issue == nil {
+		return issue
+	}
+	issueJSON, err := json.Marshal(issue)
+	if err != nil || len(issueJSON) < 2 {
+		return issue
+	}
+	stateJSON, err := json.Marshal(state)
+	if err != nil {
+		return issue
+	}
+
+	var buf bytes.Buffer
+	buf.Write(issueJSON[:len(issueJSON)-1])
+	if len(issueJSON) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"resolvedState":`)
+	buf.Write(stateJSON)
+	buf.WriteByte('}')
+	return json.RawMessage(buf.Bytes())
+}
+
+// readCommentFlag returns the comment requested via --comment or --comment-file.
+// A --comment value of "-" reads the body from stdin.
+func readCommentFlag(cmd *cobra.Command) (string, error) {
+	comment, _ := cmd.Flags().GetString("comment")
+	commentFile, _ := cmd.Flags().GetString("comment-file")
+
+	var body string
+	switch {
+	case commentFile != "":
+		data, err := os.ReadFile(commentFile)
+		if err != nil {
+			return "", fmt.Errorf("failed to read comment file: %w", err)
+		}
+		body = string(data)
+	case comment == "-":
+		data, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read comment from stdin: %w", err)
+		}
+		body = string(data)
+	default:
+		body = comment
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" && (cmd.Flags().Changed("comment") || cmd.Flags().Changed("comment-file")) {
+		return "", fmt.Errorf("comment body is empty")
+	}
+	return body, nil
+}
+
+// issueInputFile is the JSON object accepted by issue create and update
+// --input-file. Fields are pointers so keys left out of the file can be told
+// apart from zero values; an empty labels list clears labels on update.
+type issueInputFile struct {
+	Title         *string   `json:"title"`
+	Description   *string   `json:"description"`
+	TeamKey       *string   `json:"teamKey"`
+	Assignee      *string   `json:"assignee"`
+	AssigneeEmail *string   `json:"assigneeEmail"`
+	State         *string   `json:"state"`
+	Priority      *int      `json:"priority"`
+	Estimate      *int      `json:"estimate"`
+	DueDate       *string   `json:"dueDate"`
+	Labels        *[]string `json:"labels"`
+}
+
+// issueInputKeys are the keys allowed in an --input-file, in the order they
+// are listed in error messages
+var issueInputKeys = []string{
+	"title", "description", "teamKey", "assignee", "assigneeEmail",
+	"state", "priority", "estimate", "dueDate", "labels",
+}
+
+// readIssueInputFile loads and validates the --input-file payload, returning
+// nil when the flag was not given. All unknown keys are reported at once so a
+// payload can be fixed in a single pass.
+func readIssueInputFile(cmd *cobra.Command) (*issueInputFile, error) {
+	path, _ := cmd.Flags().GetString("input-file")
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read input file: %w", err)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil || raw == nil {
+		return nil, fmt.Errorf("input file %s must contain a JSON object", path)
+	}
+
+	known := make(map[string]bool, len(issueInputKeys))
+	for _, key := range issueInputKeys {
+		known[key] = true
+	}
+	var unknown []string
+	for key := range raw {
+		if !known[key] {
+			unknown = append(unknown, key)
 		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown keys in %s: %s (supported: %s)",
+			path, strings.Join(unknown, ", "), strings.Join(issueInputKeys, ", "))
+	}
+
+	var file issueInputFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("invalid input file %s: %v", path, err)
+	}
+	if err := file.validate(); err != nil {
+		return nil, fmt.Errorf("invalid input file %s: %v", path, err)
+	}
+	return &file, nil
+}
+
+// validate checks the values that can be rejected without calling the API
+func (f *issueInputFile) validate() error {
+	if f.Title != nil && strings.TrimSpace(*f.Title) == "" {
+		return fmt.Errorf("title cannot be empty")
+	}
+	if f.Assignee != nil && f.AssigneeEmail != nil {
+		return fmt.Errorf("use either assignee or assigneeEmail, not both")
+	}
+	if f.Priority != nil && (*f.Priority < 0 || *f.Priority > 4) {
+		return fmt.Errorf("priority must be between 0 and 4, got %d", *f.Priority)
+	}
+	if f.Estimate != nil && *f.Estimate < 0 {
+		return fmt.Errorf("estimate cannot be negative, got %d", *f.Estimate)
+	}
+	if f.DueDate != nil && *f.DueDate != "" {
+		if _, err := time.Parse("2006-01-02", *f.DueDate); err != nil {
+			return fmt.Errorf("dueDate must use YYYY-MM-DD format, got %q", *f.DueDate)
+		}
+	}
+	if f.Labels != nil {
+		for _, label := range *f.Labels {
+			if strings.TrimSpace(label) == "" {
+				return fmt.Errorf("labels cannot contain empty names")
+			}
+		}
+	}
+	return nil
+}
+
+// applyToFlags copies file values onto the matching flags that were not given
+// on the command line, so flags always override the file and the rest of the
+// command reads a single source. Keys without a flag on cmd are left for the
+// caller to apply.
+func (f *issueInputFile) applyToFlags(cmd *cobra.Command) error {
+	set := func(name string, value *string) error {
+		if value == nil || cmd.Flags().Lookup(name) == nil || cmd.Flags().Changed(name) {
+			return nil
+		}
+		return cmd.Flags().Set(name, *value)
+	}
+
+	assignee := f.Assignee
+	if assignee == nil {
+		assignee = f.AssigneeEmail
+	}
+	// --assign-me and --no-assignee also choose the assignee on create
+	if cmd.Flags().Changed("assign-me") || cmd.Flags().Changed("no-assignee") {
+		assignee = nil
+	}
+
+	var priority *string
+	if f.Priority != nil {
+		p := strconv.Itoa(*f.Priority)
+		priority = &p
+	}
+
+	for _, field := range []struct {
+		flag  string
+		value *string
+	}{
+		{"title", f.Title},
+		{"description", f.Description},
+		{"team", f.TeamKey},
+		{"assignee", assignee},
+		{"state", f.State},
+		{"priority", priority},
+		{"due-date", f.DueDate},
+	} {
+		if err := set(field.flag, field.value); err != nil {
+			return fmt.Errorf("invalid value for %s: %v", field.flag, err)
+		}
+	}
+	return nil
+}
+
+// checkCreateRequired reports a title or team that issue create got from
+// neither its flags nor --input-file
+func checkCreateRequired(cmd *cobra.Command) error {
+	if title, _ := cmd.Flags().GetString("title"); title == "" {
+		return fmt.Errorf("Title is required (--title, or title in --input-file)")
+	}
+	if teamKey, _ := cmd.Flags().GetString("team"); teamKey == "" {
+		return fmt.Errorf("Team is required (--team, or teamKey in --input-file)")
+	}
+	return nil
+}
+
+// resolveLabelIDs maps label names (case-insensitive) to label IDs for an
+// issue in the given team. A team's own label wins over a workspace label
+// with the same name; every unknown name is reported in the error.
+func resolveLabelIDs(ctx context.Context, client *api.Client, teamID string, names []string) ([]string, error) {
+	if len(names) == 0 {
+		return []string{}, nil
+	}
+
+	// Only the requested names are fetched, so workspaces with more labels
+	// than fit in a page still resolve; each name matches at most a team and
+	// a workspace label
+	first := 250
+	resp, err := api.ListIssueLabels(ctx, client, labelNameFilter(teamID, names), &first)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list labels: %w", err)
+	}
+
+	var labels []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+	if resp.IssueLabels != nil {
+		labels = resp.IssueLabels.Nodes
+	}
+	return matchLabelIDs(labels, names)
+}
+
+// labelNameFilter matches the labels named in names (case-insensitive) that
+// belong to the given team or to the workspace
+func labelNameFilter(teamID string, names []string) *api.IssueLabelFilter {
+	workspace := true
+	byName := make([]*api.IssueLabelFilter, 0, len(names))
+	for _, name := range names {
+		name := strings.TrimSpace(name)
+		byName = append(byName, &api.IssueLabelFilter{Name: &api.StringComparator{EqIgnoreCase: &name}})
+	}
+	return &api.IssueLabelFilter{
+		And: []*api.IssueLabelFilter{
+			{Or: []*api.IssueLabelFilter{
+				{Team: &api.NullableTeamFilter{Id: &api.IDComparator{Eq: &teamID}}},
+				{Team: &api.NullableTeamFilter{Null: &workspace}},
+			}},
+			{Or: byName},
+		},
+	}
+}
+
+// matchLabelIDs picks the label ID for each name, preferring a team label
+// over a workspace label with the same name
+func matchLabelIDs(labels []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel, names []string) ([]string, error) {
+	byName := make(map[string]*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel)
+	for _, label := range labels {
+		key := strings.ToLower(label.Name)
+		if existing, ok := byName[key]; ok && existing.Team != nil {
+			continue
+		}
+		byName[key] = label
+	}
+
+	ids := make([]string, 0, len(names))
+	var missing []string
+	for _, name := range names {
+		label, ok := byName[strings.ToLower(strings.TrimSpace(name))]
+		if !ok {
+			missing = append(missing, name)
+			continue
+		}
+		ids = append(ids, label.Id)
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("labels not found: %s", strings.Join(missing, ", "))
+	}
+	return ids, nil
+}
+
+// issueBlocker is an unresolved issue blocking one of the reported issues
+type issueBlocker struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	State      string `json:"state"`
+	StateType  string `json:"stateType"`
+	Assignee   string `json:"assignee,omitempty"`
+	URL        string `json:"url"`
+	Stalled    bool   `json:"stalled"`
+}
+
+// blockedIssue is an open issue together with what is blocking it
+type blockedIssue struct {
+	Identifier string         `json:"identifier"`
+	Title      string         `json:"title"`
+	State      string         `json:"state"`
+	URL        string         `json:"url"`
+	Blockers   []issueBlocker `json:"blockers"`
+}
+
+// fetchBlockedIssues pages through issues matching filter and collects their
+// unresolved blockers with blockedIssuesFrom
+func fetchBlockedIssues(ctx context.Context, client *api.Client, filter *api.IssueFilter) ([]blockedIssue, error) {
+	var blocked []blockedIssue
+	var after *string
+	first := 50
+
+	for {
+		resp, err := api.ListBlockedIssues(ctx, client, filter, &first, after)
+		if err != nil {
+			return nil, err
+		}
+		blocked = append(blocked, blockedIssuesFrom(resp.Issues.Nodes)...)
+
+		pageInfo := resp.Issues.PageInfo
+		if pageInfo == nil || !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return blocked, nil
+		}
+		after = pageInfo.EndCursor
+
+		if err := waitPageDelay(ctx); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// blockedIssuesFrom reduces issues to their unresolved "blocks" relations.
+// Blockers that are completed or canceled no longer block, so issues left
+// without any are dropped.
+func blockedIssuesFrom(nodes []*api.ListBlockedIssuesIssuesIssueConnectionNodesIssue) []blockedIssue {
+	var blocked []blockedIssue
+	for _, node := range nodes {
+		item := blockedIssue{Identifier: node.Identifier, Title: node.Title, URL: node.Url}
+		if node.State != nil {
+			item.State = node.State.Name
+		}
+		if node.InverseRelations != nil {
+			for _, relation := range node.InverseRelations.Nodes {
+				if relation.Type != "blocks" || relation.Issue == nil {
+					continue
+				}
+				blocker := issueBlocker{
+					Identifier: relation.Issue.Identifier,
+					Title:      relation.Issue.Title,
+					URL:        relation.Issue.Url,
+				}
+				if relation.Issue.State != nil {
+					blocker.State = relation.Issue.State.Name
+					blocker.StateType = relation.Issue.State.Type
+				}
+				if relation.Issue.Assignee != nil {
+					blocker.Assignee = relation.Issue.Assignee.Name
+				}
+				switch normalizeStateType(blocker.StateType) {
+				case "completed", "canceled":
+					continue
+				case "unstarted":
+					blocker.Stalled = true
+				}
+				item.Blockers = append(item.Blockers, blocker)
+			}
+		}
+		if len(item.Blockers) > 0 {
+			blocked = append(blocked, item)
+		}
+	}
+	return blocked
+}
+
+// printBlockedIssues prints the blockers report in the selected output mode
+func printBlockedIssues(blocked []blockedIssue, plaintext, jsonOut bool) {
+	if jsonOut {
+		if blocked == nil {
+			blocked = []blockedIssue{}
+		}
+		output.JSON(blocked)
+		return
+	}
+
+	if len(blocked) == 0 {
+		output.Info("No blocked issues", plaintext, jsonOut)
+		return
+	}
+
+	if plaintext {
+		fmt.Println("# Blocked Issues")
+		for _, item := range blocked {
+			fmt.Printf("\n## %s: %s [%s]\n", item.Identifier, item.Title, item.State)
+			for _, blocker := range item.Blockers {
+				assignee := blocker.Assignee
+				if assignee == "" {
+					assignee = "Unassigned"
+				}
+				stalled := ""
+				if blocker.Stalled {
+					stalled = " (not started)"
+				}
+				fmt.Printf("- Blocked by %s: %s [%s] (%s)%s\n", blocker.Identifier, blocker.Title, blocker.State, assignee, stalled)
+			}
+		}
+		printSummary(len(blocked), "blocked issues", "")
+		return
+	}
+
+	stalledMarker := "⚠ not started"
+	if asciiGlyphs() {
+		stalledMarker = "! not started"
+	}
+	for _, item := range blocked {
+		fmt.Printf("\n%s %s %s\n",
+			color.New(color.FgCyan, color.Bold).Sprint(item.Identifier),
+			item.Title,
+			color.New(color.FgWhite, color.Faint).Sprintf("[%s]", item.State))
+		for _, blocker := range item.Blockers {
+			assignee := blocker.Assignee
+			if assignee == "" {
+				assignee = "Unassigned"
+			}
+			line := fmt.Sprintf("  %s %s %s %s (%s)",
+				color.New(color.FgRed).Sprint("⛔ blocked by"),
+				formatStateGlyph(blocker.StateType, false),
+				color.New(color.FgCyan).Sprint(blocker.Identifier),
+				blocker.Title,
+				color.New(color.FgWhite, color.Faint).Sprint(assignee))
+			if blocker.Stalled {
+				line += " " + color.New(color.FgYellow, color.Bold).Sprint(stalledMarker)
+			}
+			fmt.Println(line)
+		}
+	}
+	printSummary(len(blocked), "blocked issues", "")
+}
+
+var issueBlockersCmd = &cobra.Command{
+	Use:   "blockers",
+	Short: "Show what is blocking your open issues",
+	Long: `List open issues that are blocked by other unresolved issues, along with each
+blocker's state and assignee. Blockers that nobody has started yet are
+highlighted as stalled, since they are the likeliest reason work is stuck.
+
+Examples:
+  lincli issue blockers                     # Blockers of your own open issues
+  lincli issue blockers --assignee jane@company.com
+  lincli issue blockers --team ENG --json`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'lincli auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		hasBlockers := true
+		filter := &api.IssueFilter{
+			State:                 &api.WorkflowStateFilter{Type: stringNin([]string{"completed", "canceled"})},
+			HasBlockedByRelations: &api.RelationExistsComparator{Eq: &hasBlockers},
+		}
+		if assignee, _ := cmd.Flags().GetString("assignee"); assignee == "me" {
+			filter.Assignee = &api.NullableUserFilter{IsMe: boolEq(true)}
+		} else if assignee != "" {
+			// Resolve names and IDs up front so an unknown user is an error
+			// rather than an empty report
+			userID, err := resolveUserID(cmd.Context(), client, assignee)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to resolve assignee: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			filter.Assignee = &api.NullableUserFilter{Id: &api.IDComparator{Eq: &userID}}
+		}
+		if team, _ := cmd.Flags().GetString("team"); team != "" {
+			filter.Team = &api.TeamFilter{Key: stringEq(team)}
+		}
+
+		blocked, err := fetchBlockedIssues(cmd.Context(), client, filter)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch blocked issues: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		if summaryOnly() {
+			printSummary(len(blocked), "blocked issues", "")
+			return
+		}
+
+		printBlockedIssues(blocked, plaintext, jsonOut)
 	},
 }
 
@@ -1139,44 +2275,65 @@ func init() {
 	issueCmd.AddCommand(issueAssignCmd)
 	issueCmd.AddCommand(issueCreateCmd)
 	issueCmd.AddCommand(issueUpdateCmd)
+	issueCmd.AddCommand(issueBlockersCmd)
 
 	// Issue list flags
-	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
-	issueListCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me'; prefix with '!' to exclude)")
+	issueListCmd.Flags().StringSliceP("state", "s", nil, "Filter by state name; repeat or comma-separate to match any of several, prefix with '!' to exclude")
+	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key (prefix with '!' to exclude)")
+	issueListCmd.Flags().String("cycle", "", "Filter by cycle number, or current/active or next/upcoming (requires --team)")
 	issueListCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
 	issueListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
 	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	issueListCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	issueListCmd.Flags().Bool("pretty-priority", false, "Add a priority column with icons to table output")
+	issueListCmd.Flags().Bool("first-response-pending", false, "Only triage/unstarted issues with no comment yet from anyone but the creator")
+	issueListCmd.MarkFlagsMutuallyExclusive("first-response-pending", "state")
+	issueListCmd.Flags().Bool("all", false, "Fetch all matching issues (ignores --limit; orders by creation time unless --sort is given)")
 
 	// Issue search flags
-	issueSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
-	issueSearchCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	issueSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me'; prefix with '!' to exclude)")
+	issueSearchCmd.Flags().StringSliceP("state", "s", nil, "Filter by state name; repeat or comma-separate to match any of several, prefix with '!' to exclude")
+	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key (prefix with '!' to exclude)")
+	issueSearchCmd.Flags().String("cycle", "", "Filter by cycle number, or current/active or next/upcoming (requires --team)")
 	issueSearchCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
 	issueSearchCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
 	issueSearchCmd.Flags().Bool("include-archived", false, "Include archived issues in results")
 	issueSearchCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
 	issueSearchCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	issueSearchCmd.Flags().Bool("pretty-priority", false, "Add a priority column with icons to table output")
+
+	// Issue blockers flags
+	issueBlockersCmd.Flags().StringP("assignee", "a", "me", "Whose issues to check (email, name, user ID, or 'me')")
+	issueBlockersCmd.Flags().StringP("team", "t", "", "Limit to a team key")
+
+	// Issue get flags
+	issueGetCmd.Flags().Bool("comments-all", false, "Fetch and show every comment on the issue, including replies")
 
 	// Issue create flags
-	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required)")
+	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required unless set by --input-file)")
 	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required unless set by --input-file)")
 	issueCreateCmd.Flags().Int("priority", 3, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueCreateCmd.Flags().BoolP("assign-me", "m", false, "Assign to yourself")
-	_ = issueCreateCmd.MarkFlagRequired("title")
-	_ = issueCreateCmd.MarkFlagRequired("team")
+	issueCreateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
+	issueCreateCmd.Flags().Bool("no-assignee", false, "Leave the issue unassigned, ignoring config defaults")
+	issueCreateCmd.MarkFlagsMutuallyExclusive("assign-me", "assignee", "no-assignee")
+	issueCreateCmd.Flags().String("input-file", "", "Read the issue from a JSON file (flags override file values)")
 
 	// Issue update flags
 	issueUpdateCmd.Flags().String("title", "", "New title for the issue")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description for the issue")
 	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
-	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done')")
+	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done') or type (e.g., 'started')")
 	issueUpdateCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format, or empty to remove)")
+	issueUpdateCmd.Flags().String("comment", "", "Add a comment after updating (use '-' to read from stdin)")
+	issueUpdateCmd.Flags().String("comment-file", "", "Add a comment read from a file after updating")
+	issueUpdateCmd.MarkFlagsMutuallyExclusive("comment", "comment-file")
+	issueUpdateCmd.Flags().String("input-file", "", "Read fields to update from a JSON file (flags override file values)")
 }
 
 // Filter helper functions for type-safe filter building
@@ -1184,6 +2341,10 @@ func stringEq(val string) *api.StringComparator {
 	return &api.StringComparator{Eq: &val}
 }
 
+func stringNeq(val string) *api.StringComparator {
+	return &api.StringComparator{Neq: &val}
+}
+
 func stringIn(vals []string) *api.StringComparator {
 	return &api.StringComparator{In: vals}
 }
@@ -1208,39 +2369,62 @@ func dateGte(val string) *api.DateComparator {
 func buildIssueFilterTyped(cmd *cobra.Command) *api.IssueFilter {
 	filter := &api.IssueFilter{}
 
-	// Assignee filter
+	if err := checkNegatedFilters(cmd); err != nil {
+		output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+		os.Exit(1)
+	}
+
+	// Assignee filter - "!" excludes, keeping unassigned issues
 	if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
-		if assignee == "me" {
-			filter.Assignee = &api.NullableUserFilter{
-				IsMe: boolEq(true),
-			}
-		} else {
+		value, negate := negated(assignee)
+		switch {
+		case value == "me":
+			filter.Assignee = &api.NullableUserFilter{IsMe: boolEq(!negate)}
+		case negate:
+			filter.Assignee = &api.NullableUserFilter{Email: stringNeq(value)}
+		default:
+			filter.Assignee = &api.NullableUserFilter{Email: stringEq(value)}
+		}
+		if negate {
+			unassigned := true
 			filter.Assignee = &api.NullableUserFilter{
-				Email: stringEq(assignee),
+				Or: []*api.NullableUserFilter{{Null: &unassigned}, filter.Assignee},
 			}
 		}
 	}
 
-	// State filter
-	state, _ := cmd.Flags().GetString("state")
-	if state != "" {
+	// State filter - several names match any of them; "!" names are excluded
+	include, exclude := splitNegated(stateNames(cmd))
+	if len(include) > 0 || len(exclude) > 0 {
 		filter.State = &api.WorkflowStateFilter{
-			Name: stringEq(state),
+			Name: stringMatch(include, exclude),
 		}
-	} else {
-		// Exclude completed/canceled unless explicitly included
-		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
-		if !includeCompleted {
-			filter.State = &api.WorkflowStateFilter{
-				Type: stringNin([]string{"completed", "canceled"}),
+	}
+	if len(include) == 0 {
+		var stateType *api.StringComparator
+		if pending, _ := cmd.Flags().GetBool("first-response-pending"); pending {
+			// Only issues nobody has picked up yet can be awaiting a first response
+			stateType = stringIn([]string{"triage", "unstarted"})
+		} else if includeCompleted, _ := cmd.Flags().GetBool("include-completed"); !includeCompleted {
+			// Exclude completed/canceled unless explicitly included
+			stateType = stringNin([]string{"completed", "canceled"})
+		}
+		if stateType != nil {
+			if filter.State == nil {
+				filter.State = &api.WorkflowStateFilter{}
 			}
+			filter.State.Type = stateType
 		}
 	}
 
 	// Team filter
 	if team, _ := cmd.Flags().GetString("team"); team != "" {
+		value, negate := negated(team)
 		filter.Team = &api.TeamFilter{
-			Key: stringEq(team),
+			Key: stringEq(value),
+		}
+		if negate {
+			filter.Team.Key = stringNeq(value)
 		}
 	}
 
@@ -1264,3 +2448,435 @@ func buildIssueFilterTyped(cmd *cobra.Command) *api.IssueFilter {
 
 	return filter
 }
+
+// printCreatedWindow reports the effective --newer-than window of filter on
+// stderr under --verbose
+func printCreatedWindow(cmd *cobra.Command, filter *api.IssueFilter) {
+	if !viper.GetBool("verbose") {
+		return
+	}
+	newerThan, _ := cmd.Flags().GetString("newer-than")
+	if newerThan == "" {
+		newerThan = utils.DefaultTimeExpression + " (default)"
+	}
+	if filter.CreatedAt == nil || filter.CreatedAt.Gte == nil {
+		fmt.Fprintf(os.Stderr, "Created window: %s (no date filter)\n", newerThan)
+	} else {
+		fmt.Fprintf(os.Stderr, "Created window: %s (created on or after %s)\n", newerThan, *filter.CreatedAt.Gte)
+	}
+}
+
+// stateNames returns the de-duplicated --state values in the order given
+func stateNames(cmd *cobra.Command) []string {
+	values, _ := cmd.Flags().GetStringSlice("state")
+	names := []string{}
+	seen := make(map[string]bool)
+	for _, value := range values {
+		name := strings.TrimSpace(value)
+		if name == "" || seen[strings.ToLower(name)] {
+			continue
+		}
+		seen[strings.ToLower(name)] = true
+		names = append(names, name)
+	}
+	return names
+}
+
+// negated strips a leading "!" from a filter value and reports whether it was present
+func negated(value string) (string, bool) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "!") {
+		return strings.TrimSpace(value[1:]), true
+	}
+	return value, false
+}
+
+// checkNegatedFilters rejects a "!" with nothing after it in --assignee,
+// --state, or --team, which would otherwise filter on an empty value
+func checkNegatedFilters(cmd *cobra.Command) error {
+	check := func(flag, value string) error {
+		if v, negate := negated(value); negate && v == "" {
+			return fmt.Errorf("--%s %q has nothing to exclude; put a value after '!'", flag, value)
+		}
+		return nil
+	}
+
+	if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
+		if err := check("assignee", assignee); err != nil {
+			return err
+		}
+	}
+	states, _ := cmd.Flags().GetStringSlice("state")
+	for _, state := range states {
+		if err := check("state", state); err != nil {
+			return err
+		}
+	}
+	if team, _ := cmd.Flags().GetString("team"); team != "" {
+		if err := check("team", team); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// splitNegated separates filter values into those to match and, for values
+// prefixed with "!", those to exclude
+func splitNegated(values []string) (include, exclude []string) {
+	for _, value := range values {
+		if v, negate := negated(value); negate {
+			exclude = append(exclude, v)
+		} else {
+			include = append(include, v)
+		}
+	}
+	return include, exclude
+}
+
+// stringMatch builds a comparator matching any include value and none of the
+// exclude values, using Eq/Neq for single values and In/Nin otherwise
+func stringMatch(include, exclude []string) *api.StringComparator {
+	comparator := &api.StringComparator{}
+	if len(include) == 1 {
+		comparator.Eq = &include[0]
+	} else if len(include) > 1 {
+		comparator.In = include
+	}
+	if len(exclude) == 1 {
+		comparator.Neq = &exclude[0]
+	} else if len(exclude) > 1 {
+		comparator.Nin = exclude
+	}
+	return comparator
+}
+
+// validateStateFilter checks --state names against the workflow states of the
+// --team team, rewriting them to the team's exact spelling. Without --team the
+// names are sent as given, since each team defines its own states.
+func validateStateFilter(ctx context.Context, client *api.Client, cmd *cobra.Command, filter *api.IssueFilter) error {
+	teamKey, _ := cmd.Flags().GetString("team")
+	names := stateNames(cmd)
+	if _, negate := negated(teamKey); teamKey == "" || negate || len(names) == 0 {
+		return nil
+	}
+
+	resp, err := api.GetTeamStates(ctx, client, teamKey)
+	if err != nil {
+		return fmt.Errorf("failed to get states for team %s: %v", teamKey, err)
+	}
+	if resp.Team.States == nil {
+		return fmt.Errorf("team %s has no workflow states", teamKey)
+	}
+
+	validNames := []string{}
+	for _, state := range resp.Team.States.Nodes {
+		validNames = append(validNames, state.Name)
+	}
+
+	include, exclude := splitNegated(names)
+	if include, err = canonicalStateNames(teamKey, include, validNames); err != nil {
+		return err
+	}
+	if exclude, err = canonicalStateNames(teamKey, exclude, validNames); err != nil {
+		return err
+	}
+
+	filter.State.Name = stringMatch(include, exclude)
+	return nil
+}
+
+// canonicalStateNames matches names case-insensitively against a team's state
+// names and returns them in the team's spelling. The first unknown name fails
+// with the list of valid states.
+func canonicalStateNames(teamKey string, names, validNames []string) ([]string, error) {
+	valid := make(map[string]string, len(validNames))
+	for _, name := range validNames {
+		valid[strings.ToLower(name)] = name
+	}
+
+	resolved := make([]string, 0, len(names))
+	for _, name := range names {
+		canonical, ok := valid[strings.ToLower(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown state %q for team %s; valid states: %s", name, teamKey, strings.Join(validNames, ", "))
+		}
+		resolved = append(resolved, canonical)
+	}
+	return resolved, nil
+}
+
+// workflowStateTypes are the state type keywords accepted as a fallback by
+// issue update --state when no state has the given name
+var workflowStateTypes = map[string]bool{
+	"triage":    true,
+	"backlog":   true,
+	"unstarted": true,
+	"started":   true,
+	"completed": true,
+	"canceled":  true,
+}
+
+// workflowState is satisfied by the generated workflow state types of both
+// GetIssue (team states embedded in the issue) and GetTeamStates
+type workflowState interface {
+	comparable
+	GetName() string
+	GetType() string
+	GetPosition() float64
+}
+
+// resolveWorkflowState finds a team state by name (case-insensitive). If no
+// name matches and the input is a state type keyword, it falls back to the
+// team's first state of that type by position and reports byType. The zero
+// value (nil) is returned when nothing matches.
+func resolveWorkflowState[S workflowState](states []S, name string) (state S, byType bool) {
+	for _, s := range states {
+		if strings.EqualFold(s.GetName(), name) {
+			return s, false
+		}
+	}
+
+	var none S
+	stateType := strings.ToLower(strings.TrimSpace(name))
+	if !workflowStateTypes[stateType] {
+		return none, false
+	}
+	for _, s := range states {
+		if s.GetType() == stateType && (state == none || s.GetPosition() < state.GetPosition()) {
+			state = s
+		}
+	}
+	return state, state != none
+}
+
+// issuePageSize is the page size used when paginating with --all
+const issuePageSize = 100
+
+// stableExportOrder returns the ordering to use for --all exports. Creation
+// time is immutable, so it is the default; an explicit mutable sort is kept
+// but flagged because edits during the export can shift items across pages.
+func stableExportOrder(cmd *cobra.Command, orderBy *api.PaginationOrderBy) *api.PaginationOrderBy {
+	if !cmd.Flags().Changed("sort") {
+		val := api.PaginationOrderByCreatedat
+		return &val
+	}
+	if orderBy != nil && *orderBy == api.PaginationOrderByUpdatedat && !viper.GetBool("json") && !viper.GetBool("quiet") {
+		fmt.Fprintln(os.Stderr, "Warning: --sort updated is not stable across pages; issues edited during the export may be skipped or repeated")
+	}
+	return orderBy
+}
+
+// countIssuesUpTo counts the issues matching filter with a single request
+// for at most limit IDs, reporting whether more issues match beyond that
+func countIssuesUpTo(ctx context.Context, client *api.Client, filter *api.IssueFilter, limit int) (int, bool, error) {
+	resp, err := api.CountIssues(ctx, client, filter, &limit, nil)
+	if err != nil {
+		return 0, false, err
+	}
+	more := resp.Issues.PageInfo != nil && resp.Issues.PageInfo.HasNextPage
+	return len(resp.Issues.Nodes), more, nil
+}
+
+// fetchAllIssues pages through ListIssues until every matching issue has been
+// fetched. See forEachIssuePage for the ordering guarantees.
+func fetchAllIssues(ctx context.Context, client *api.Client, filter *api.IssueFilter, orderBy *api.PaginationOrderBy) ([]*api.ListIssuesIssuesIssueConnectionNodesIssue, error) {
+	var issues []*api.ListIssuesIssuesIssueConnectionNodesIssue
+	err := forEachIssuePage(ctx, client, filter, orderBy, func(page []*api.ListIssuesIssuesIssueConnectionNodesIssue) error {
+		issues = append(issues, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return issues, nil
+}
+
+// forEachIssuePage pages through ListIssues, calling fn with each page of
+// matching issues as it arrives. Issues created after the call starts are
+// excluded via a createdAt ceiling, and results are de-duplicated by ID in
+// case ordering shifts.
+func forEachIssuePage(ctx context.Context, client *api.Client, filter *api.IssueFilter, orderBy *api.PaginationOrderBy, fn func([]*api.ListIssuesIssuesIssueConnectionNodesIssue) error) error {
+	fetch := func(ctx context.Context, filter *api.IssueFilter, first int, after *string) (*api.ListIssuesIssuesIssueConnection, error) {
+		resp, err := api.ListIssues(ctx, client, filter, &first, after, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Issues, nil
+	}
+	return forEachFetchedIssuePage(ctx, filter, fetch, fn)
+}
+
+// issuePageFetcher fetches one page of issues matching filter after the cursor
+type issuePageFetcher func(ctx context.Context, filter *api.IssueFilter, first int, after *string) (*api.ListIssuesIssuesIssueConnection, error)
+
+// setCreatedCeiling limits filter to issues created up to now, so an export
+// sees a snapshot of the result set as it was when it started
+func setCreatedCeiling(filter *api.IssueFilter, now time.Time) {
+	ceiling := now.UTC().Format(time.RFC3339)
+	if filter.CreatedAt == nil {
+		filter.CreatedAt = &api.DateComparator{}
+	}
+	filter.CreatedAt.Lte = &ceiling
+}
+
+// forEachFetchedIssuePage implements forEachIssuePage over any page fetcher
+func forEachFetchedIssuePage(ctx context.Context, filter *api.IssueFilter, fetch issuePageFetcher, fn func([]*api.ListIssuesIssuesIssueConnectionNodesIssue) error) error {
+	setCreatedCeiling(filter, time.Now())
+
+	seen := make(map[string]bool)
+	var after *string
+
+	for {
+		conn, err := fetch(ctx, filter, issuePageSize, after)
+		if err != nil {
+			return err
+		}
+		if conn == nil {
+			return nil
+		}
+
+		page := make([]*api.ListIssuesIssuesIssueConnectionNodesIssue, 0, len(conn.Nodes))
+		for _, node := range conn.Nodes {
+			if seen[node.IssueListFields.Id] {
+				continue
+			}
+			seen[node.IssueListFields.Id] = true
+			page = append(page, node)
+		}
+		if err := fn(page); err != nil {
+			return err
+		}
+
+		pageInfo := conn.PageInfo
+		if pageInfo == nil || !pageInfo.HasNextPage || pageInfo.EndCursor == nil {
+			return nil
+		}
+		after = pageInfo.EndCursor
+
+		if err := waitPageDelay(ctx); err != nil {
+			return err
+		}
+	}
+}
+
+// awaitingFirstResponse reports whether nobody but the issue's creator has
+// commented. Comments without a user (integrations and synced customer
+// messages) are not a response. Only the first 50 comments are inspected.
+func awaitingFirstResponse(issue *api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) bool {
+	if issue.Comments == nil {
+		return true
+	}
+	for _, comment := range issue.Comments.Nodes {
+		if comment.User == nil {
+			continue
+		}
+		if issue.Creator == nil || comment.User.Id != issue.Creator.Id {
+			return false
+		}
+	}
+	return true
+}
+
+// fetchFirstResponsePending pages through issues matching filter and keeps
+// those awaiting a first response, stopping once limit are found. A limit
+// <= 0 fetches every page as an export: like --all, the result set is
+// snapshotted with a createdAt ceiling. hasNextPage reports whether more
+// pending issues may be left.
+func fetchFirstResponsePending(ctx context.Context, client *api.Client, filter *api.IssueFilter, orderBy *api.PaginationOrderBy, limit int) (issues []*api.ListIssuesIssuesIssueConnectionNodesIssue, hasNextPage bool, err error) {
+	fetch := func(ctx context.Context, filter *api.IssueFilter, first int, after *string) (*api.ListIssuesWithRespondersIssuesIssueConnection, error) {
+		resp, err := api.ListIssuesWithResponders(ctx, client, filter, &first, after, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		return resp.Issues, nil
+	}
+	return collectFirstResponsePending(ctx, filter, fetch, limit)
+}
+
+// responderPageFetcher fetches one page of issues with their creator and
+// commenters after the cursor
+type responderPageFetcher func(ctx context.Context, filter *api.IssueFilter, first int, after *string) (*api.ListIssuesWithRespondersIssuesIssueConnection, error)
+
+// collectFirstResponsePending implements fetchFirstResponsePending over any
+// page fetcher
+func collectFirstResponsePending(ctx context.Context, filter *api.IssueFilter, fetch responderPageFetcher, limit int) (issues []*api.ListIssuesIssuesIssueConnectionNodesIssue, hasNextPage bool, err error) {
+	if limit <= 0 {
+		setCreatedCeiling(filter, time.Now())
+	}
+
+	seen := make(map[string]bool)
+	var after *string
+
+	for {
+		conn, err := fetch(ctx, filter, 50, after)
+		if err != nil {
+			return nil, false, err
+		}
+		if conn == nil {
+			return issues, false, nil
+		}
+		pageInfo := conn.PageInfo
+		morePages := pageInfo != nil && pageInfo.HasNextPage && pageInfo.EndCursor != nil
+
+		for i, node := range conn.Nodes {
+			if seen[node.IssueListFields.Id] || !awaitingFirstResponse(node) {
+				continue
+			}
+			seen[node.IssueListFields.Id] = true
+			issues = append(issues, &api.ListIssuesIssuesIssueConnectionNodesIssue{IssueListFields: node.IssueListFields})
+			if limit > 0 && len(issues) >= limit {
+				return issues, morePages || anyAwaitingFirstResponse(conn.Nodes[i+1:]), nil
+			}
+		}
+
+		if !morePages {
+			return issues, false, nil
+		}
+		after = pageInfo.EndCursor
+
+		if err := waitPageDelay(ctx); err != nil {
+			return nil, false, err
+		}
+	}
+}
+
+// anyAwaitingFirstResponse reports whether any of issues awaits a first response
+func anyAwaitingFirstResponse(issues []*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) bool {
+	for _, issue := range issues {
+		if awaitingFirstResponse(issue) {
+			return true
+		}
+	}
+	return false
+}
+
+// printDefaultWindowNote tells the user when the implicit --newer-than window,
+// rather than --limit, hid matching issues. olderIssuesExist is called with
+// the filter moved to before the window, and only when the note could apply,
+// since it costs an API request.
+func printDefaultWindowNote(cmd *cobra.Command, filter *api.IssueFilter, hasNextPage bool, olderIssuesExist func(*api.IssueFilter) (bool, error)) {
+	if viper.GetBool("json") || viper.GetBool("quiet") {
+		return
+	}
+	if newerThan, _ := cmd.Flags().GetString("newer-than"); newerThan != "" {
+		return
+	}
+	if hasNextPage || filter == nil || filter.CreatedAt == nil || filter.CreatedAt.Gte == nil {
+		return
+	}
+
+	older := *filter
+	older.CreatedAt = &api.DateComparator{Lt: filter.CreatedAt.Gte}
+	exists, err := olderIssuesExist(&older)
+	if err != nil || !exists {
+		return
+	}
+
+	note := fmt.Sprintf("Older issues match but were created before the last %s; use --newer-than all_time to include them",
+		utils.DescribeTimeExpression(utils.DefaultTimeExpression))
+	if viper.GetBool("plaintext") {
+		fmt.Fprintf(os.Stderr, "Note: %s\n", note)
+	} else {
+		fmt.Fprintf(os.Stderr, "%s %s\n", color.New(color.FgWhite, color.Faint).Sprint("→"), note)
+	}
+}
@@ -0,0 +1,1259 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/shanedolley/lincli/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// captureOutput runs fn with os.Stdout and os.Stderr redirected and returns
+// what was written to each
+func captureOutput(t *testing.T, fn func()) (stdout, stderr string) {
+	t.Helper()
+
+	outFile, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errFile, err := os.CreateTemp(t.TempDir(), "stderr")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	origOut, origErr := os.Stdout, os.Stderr
+	os.Stdout, os.Stderr = outFile, errFile
+	defer func() { os.Stdout, os.Stderr = origOut, origErr }()
+
+	fn()
+
+	read := func(f *os.File) string {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			t.Fatal(err)
+		}
+		data, err := io.ReadAll(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return string(data)
+	}
+	return read(outFile), read(errFile)
+}
+
+func TestReportUpdateWithComment(t *testing.T) {
+	issue := &api.UpdateIssueIssueUpdateIssuePayloadIssue{}
+	comment := &api.CreateCommentCommentCreateCommentPayloadComment{}
+	commentErr := errors.New("rate limited")
+
+	tests := []struct {
+		name       string
+		issue      *api.UpdateIssueIssueUpdateIssuePayloadIssue
+		comment    *api.CreateCommentCommentCreateCommentPayloadComment
+		commentErr error
+		wantOK     bool
+		wantStdout []string
+		wantStderr []string
+	}{
+		{
+			name:       "update and comment succeed",
+			issue:      issue,
+			comment:    comment,
+			wantOK:     true,
+			wantStdout: []string{"Updated issue LIN-1", "Added comment to LIN-1"},
+		},
+		{
+			name:       "update succeeds, comment fails",
+			issue:      issue,
+			commentErr: commentErr,
+			wantOK:     false,
+			wantStdout: []string{"Updated issue LIN-1"},
+			wantStderr: []string{"Failed to add comment to LIN-1: rate limited"},
+		},
+		{
+			name:       "comment only, comment fails",
+			commentErr: commentErr,
+			wantOK:     false,
+			wantStderr: []string{"Failed to add comment to LIN-1: rate limited"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ok bool
+			stdout, stderr := captureOutput(t, func() {
+				ok = reportUpdateWithComment("LIN-1", tt.issue, nil, tt.comment, tt.commentErr, true, false)
+			})
+			if ok != tt.wantOK {
+				t.Errorf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			for _, want := range tt.wantStdout {
+				if !strings.Contains(stdout, want) {
+					t.Errorf("stdout %q does not contain %q", stdout, want)
+				}
+			}
+			for _, want := range tt.wantStderr {
+				if !strings.Contains(stderr, want) {
+					t.Errorf("stderr %q does not contain %q", stderr, want)
+				}
+			}
+			if tt.issue == nil && strings.Contains(stdout, "Updated issue") {
+				t.Errorf("stdout %q reports an update that was not made", stdout)
+			}
+		})
+	}
+}
+
+func TestReportUpdateWithCommentJSON(t *testing.T) {
+	issue := &api.UpdateIssueIssueUpdateIssuePayloadIssue{}
+
+	var ok bool
+	stdout, _ := captureOutput(t, func() {
+		ok = reportUpdateWithComment("LIN-1", issue, nil, nil, errors.New("rate limited"), false, true)
+	})
+	if ok {
+		t.Error("ok = true, want false when the comment failed")
+	}
+
+	var result map[string]interface{}
+	if err := json.Unmarshal([]byte(stdout), &result); err != nil {
+		t.Fatalf("output is not JSON: %v\n%s", err, stdout)
+	}
+	if _, found := result["issue"]; !found {
+		t.Error("JSON result is missing the updated issue")
+	}
+	if result["commentError"] != "rate limited" {
+		t.Errorf("commentError = %v, want %q", result["commentError"], "rate limited")
+	}
+	if _, found := result["comment"]; found {
+		t.Error("JSON result has a comment although it failed")
+	}
+}
+
+func newCommentFlagCmd() *cobra.Command {
+	cmd := &cobra.Command{}
+	cmd.Flags().String("comment", "", "")
+	cmd.Flags().String("comment-file", "", "")
+	return cmd
+}
+
+func TestReadCommentFlag(t *testing.T) {
+	dir := t.TempDir()
+	commentFile := filepath.Join(dir, "comment.md")
+	if err := os.WriteFile(commentFile, []byte("  From a file\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	emptyFile := filepath.Join(dir, "empty.md")
+	if err := os.WriteFile(emptyFile, []byte("\n\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		flags   map[string]string
+		stdin   string
+		want    string
+		wantErr bool
+	}{
+		{name: "no flags", want: ""},
+		{name: "inline", flags: map[string]string{"comment": "Looks good"}, want: "Looks good"},
+		{name: "stdin", flags: map[string]string{"comment": "-"}, stdin: "From stdin\n", want: "From stdin"},
+		{name: "file", flags: map[string]string{"comment-file": commentFile}, want: "From a file"},
+		{name: "empty inline", flags: map[string]string{"comment": "   "}, wantErr: true},
+		{name: "empty stdin", flags: map[string]string{"comment": "-"}, stdin: "", wantErr: true},
+		{name: "empty file", flags: map[string]string{"comment-file": emptyFile}, wantErr: true},
+		{name: "missing file", flags: map[string]string{"comment-file": filepath.Join(dir, "missing.md")}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newCommentFlagCmd()
+			for name, value := range tt.flags {
+				if err := cmd.Flags().Set(name, value); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			stdin, err := os.CreateTemp(t.TempDir(), "stdin")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := stdin.WriteString(tt.stdin); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := stdin.Seek(0, io.SeekStart); err != nil {
+				t.Fatal(err)
+			}
+			origStdin := os.Stdin
+			os.Stdin = stdin
+			defer func() { os.Stdin = origStdin }()
+
+			got, err := readCommentFlag(cmd)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func issueNode(id string) *api.ListIssuesIssuesIssueConnectionNodesIssue {
+	return &api.ListIssuesIssuesIssueConnectionNodesIssue{IssueListFields: api.IssueListFields{Id: id}}
+}
+
+// fakeIssuePages serves pages keyed by cursor ("" for the first page); each
+// page's end cursor is the key of the next page
+func fakeIssuePages(t *testing.T, pages map[string][]string, next map[string]string) (issuePageFetcher, *[]*api.IssueFilter) {
+	var filters []*api.IssueFilter
+	fetch := func(ctx context.Context, filter *api.IssueFilter, first int, after *string) (*api.ListIssuesIssuesIssueConnection, error) {
+		filters = append(filters, filter)
+		cursor := ""
+		if after != nil {
+			cursor = *after
+		}
+		ids, ok := pages[cursor]
+		if !ok {
+			t.Fatalf("unexpected cursor %q", cursor)
+		}
+
+		conn := &api.ListIssuesIssuesIssueConnection{PageInfo: &api.ListIssuesIssuesIssueConnectionPageInfo{}}
+		for _, id := range ids {
+			conn.Nodes = append(conn.Nodes, issueNode(id))
+		}
+		if nextCursor, ok := next[cursor]; ok {
+			conn.PageInfo.HasNextPage = true
+			conn.PageInfo.EndCursor = &nextCursor
+		}
+		return conn, nil
+	}
+	return fetch, &filters
+}
+
+func TestForEachFetchedIssuePageDeduplicatesShiftedIssues(t *testing.T) {
+	// An issue moved between requests, so "c" shows up on both pages
+	fetch, filters := fakeIssuePages(t,
+		map[string][]string{"": {"a", "b", "c"}, "p2": {"c", "d", "e"}, "p3": {"e", "f"}},
+		map[string]string{"": "p2", "p2": "p3"},
+	)
+
+	gte := "2024-01-01T00:00:00Z"
+	filter := &api.IssueFilter{CreatedAt: &api.DateComparator{Gte: &gte}}
+
+	var got []string
+	var pageSizes []int
+	err := forEachFetchedIssuePage(context.Background(), filter, fetch, func(page []*api.ListIssuesIssuesIssueConnectionNodesIssue) error {
+		pageSizes = append(pageSizes, len(page))
+		for _, issue := range page {
+			got = append(got, issue.Id)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "a,b,c,d,e,f"; strings.Join(got, ",") != want {
+		t.Errorf("issues = %v, want %s", got, want)
+	}
+	if want := []int{3, 2, 1}; fmt.Sprint(pageSizes) != fmt.Sprint(want) {
+		t.Errorf("page sizes = %v, want %v", pageSizes, want)
+	}
+
+	if len(*filters) != 3 {
+		t.Fatalf("fetched %d pages, want 3", len(*filters))
+	}
+	for _, f := range *filters {
+		if f.CreatedAt == nil || f.CreatedAt.Lte == nil {
+			t.Fatal("page request has no createdAt ceiling")
+		}
+		if f.CreatedAt.Gte == nil || *f.CreatedAt.Gte != gte {
+			t.Error("createdAt ceiling replaced the existing lower bound")
+		}
+		if *f.CreatedAt.Lte != *(*filters)[0].CreatedAt.Lte {
+			t.Error("createdAt ceiling changed between pages")
+		}
+	}
+}
+
+func TestSetCreatedCeiling(t *testing.T) {
+	filter := &api.IssueFilter{}
+	setCreatedCeiling(filter, time.Date(2024, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 2*60*60)))
+	if filter.CreatedAt == nil || filter.CreatedAt.Lte == nil || *filter.CreatedAt.Lte != "2024-05-01T10:00:00Z" {
+		t.Errorf("ceiling = %+v, want lte 2024-05-01T10:00:00Z", filter.CreatedAt)
+	}
+}
+
+// newIssueFilterCmd returns a command with the issue list filter flags,
+// parsed from args
+func newIssueFilterCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().StringP("assignee", "a", "", "")
+	cmd.Flags().StringSliceP("state", "s", nil, "")
+	cmd.Flags().StringP("team", "t", "", "")
+	cmd.Flags().IntP("priority", "r", -1, "")
+	cmd.Flags().BoolP("include-completed", "c", false, "")
+	cmd.Flags().Bool("first-response-pending", false, "")
+	cmd.Flags().StringP("newer-than", "n", "", "")
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestBuildIssueFilterTypedStateNames(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want []string
+	}{
+		{name: "repeated", args: []string{"--state", "Todo", "-s", "In Progress"}, want: []string{"Todo", "In Progress"}},
+		{name: "comma-separated", args: []string{"--state", "Todo,In Review"}, want: []string{"Todo", "In Review"}},
+		{name: "mixed, de-duplicated", args: []string{"--state", "Todo, In Review", "--state", "todo"}, want: []string{"Todo", "In Review"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := buildIssueFilterTyped(newIssueFilterCmd(t, tt.args...))
+			if filter.State == nil || filter.State.Name == nil {
+				t.Fatal("no state name filter")
+			}
+			if got := filter.State.Name.In; fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Name.In = %q, want %q", got, tt.want)
+			}
+			if filter.State.Name.Nin != nil {
+				t.Errorf("Name.Nin = %q, want none", filter.State.Name.Nin)
+			}
+			// Named states replace the default completed/canceled exclusion
+			if filter.State.Type != nil {
+				t.Errorf("State.Type = %+v, want no type filter", filter.State.Type)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilterTypedSingleState(t *testing.T) {
+	filter := buildIssueFilterTyped(newIssueFilterCmd(t, "--state", "Todo"))
+	if filter.State == nil || filter.State.Name == nil || filter.State.Name.Eq == nil || *filter.State.Name.Eq != "Todo" {
+		t.Fatalf("State = %+v, want name eq Todo", filter.State)
+	}
+	if filter.State.Name.In != nil {
+		t.Errorf("Name.In = %q, want none for a single state", filter.State.Name.In)
+	}
+}
+
+func TestBuildIssueFilterTypedDefaultStateType(t *testing.T) {
+	filter := buildIssueFilterTyped(newIssueFilterCmd(t))
+	if filter.State == nil || filter.State.Type == nil || fmt.Sprint(filter.State.Type.Nin) != "[completed canceled]" {
+		t.Fatalf("State = %+v, want type nin [completed canceled]", filter.State)
+	}
+	if filter.State.Name != nil {
+		t.Errorf("State.Name = %+v, want none", filter.State.Name)
+	}
+}
+
+func TestBuildIssueFilterTypedNegatedAssignee(t *testing.T) {
+	tests := []struct {
+		name     string
+		assignee string
+		check    func(*api.NullableUserFilter) bool
+	}{
+		{name: "not me", assignee: "!me", check: func(f *api.NullableUserFilter) bool {
+			return f.IsMe != nil && f.IsMe.Eq != nil && !*f.IsMe.Eq
+		}},
+		{name: "not email", assignee: "! a@b.c", check: func(f *api.NullableUserFilter) bool {
+			return f.Email != nil && f.Email.Neq != nil && *f.Email.Neq == "a@b.c"
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := buildIssueFilterTyped(newIssueFilterCmd(t, "--assignee", tt.assignee))
+			if filter.Assignee == nil || len(filter.Assignee.Or) != 2 {
+				t.Fatalf("Assignee = %+v, want or [unassigned, exclusion]", filter.Assignee)
+			}
+			if null := filter.Assignee.Or[0].Null; null == nil || !*null {
+				t.Errorf("Or[0] = %+v, want null: true to keep unassigned issues", filter.Assignee.Or[0])
+			}
+			if !tt.check(filter.Assignee.Or[1]) {
+				t.Errorf("Or[1] = %+v, want exclusion of %q", filter.Assignee.Or[1], tt.assignee)
+			}
+		})
+	}
+}
+
+func TestBuildIssueFilterTypedNegatedTeam(t *testing.T) {
+	filter := buildIssueFilterTyped(newIssueFilterCmd(t, "--team", "!OPS"))
+	if filter.Team == nil || filter.Team.Key == nil || filter.Team.Key.Neq == nil || *filter.Team.Key.Neq != "OPS" {
+		t.Fatalf("Team = %+v, want key neq OPS", filter.Team)
+	}
+	if filter.Team.Key.Eq != nil {
+		t.Errorf("Team.Key.Eq = %q, want none", *filter.Team.Key.Eq)
+	}
+}
+
+func TestBuildIssueFilterTypedNegatedStates(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		wantIn   []string
+		wantNeq  string
+		wantNin  []string
+		wantType bool
+	}{
+		{name: "single exclusion", args: []string{"--state", "!Blocked"}, wantNeq: "Blocked", wantType: true},
+		{name: "several exclusions", args: []string{"--state", "!Blocked,!Duplicate"}, wantNin: []string{"Blocked", "Duplicate"}, wantType: true},
+		{name: "include and exclude", args: []string{"-s", "Todo", "-s", "In Review", "-s", "!Blocked"}, wantIn: []string{"Todo", "In Review"}, wantNeq: "Blocked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filter := buildIssueFilterTyped(newIssueFilterCmd(t, tt.args...))
+			if filter.State == nil || filter.State.Name == nil {
+				t.Fatalf("State = %+v, want a name filter", filter.State)
+			}
+			name := filter.State.Name
+			if fmt.Sprint(name.In) != fmt.Sprint(tt.wantIn) {
+				t.Errorf("Name.In = %q, want %q", name.In, tt.wantIn)
+			}
+			if fmt.Sprint(name.Nin) != fmt.Sprint(tt.wantNin) {
+				t.Errorf("Name.Nin = %q, want %q", name.Nin, tt.wantNin)
+			}
+			gotNeq := ""
+			if name.Neq != nil {
+				gotNeq = *name.Neq
+			}
+			if gotNeq != tt.wantNeq {
+				t.Errorf("Name.Neq = %q, want %q", gotNeq, tt.wantNeq)
+			}
+			if name.Eq != nil {
+				t.Errorf("Name.Eq = %q, want none", *name.Eq)
+			}
+			// Exclusions alone still hide completed issues by default
+			if hasType := filter.State.Type != nil; hasType != tt.wantType {
+				t.Errorf("State.Type = %+v, want type filter: %v", filter.State.Type, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestCheckNegatedFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		wantErr string
+	}{
+		{name: "no filters", args: nil},
+		{name: "negated values", args: []string{"--assignee", "!me", "--state", "!Done", "--team", "!OPS"}},
+		{name: "bare assignee", args: []string{"--assignee", "!"}, wantErr: "--assignee"},
+		{name: "bare state", args: []string{"--state", "Todo,!"}, wantErr: "--state"},
+		{name: "bare state with spaces", args: []string{"--state", "! "}, wantErr: "--state"},
+		{name: "bare team", args: []string{"--team", "!"}, wantErr: "--team"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkNegatedFilters(newIssueFilterCmd(t, tt.args...))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkNegatedFilters() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkNegatedFilters() = %v, want error mentioning %s", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPrintCreatedWindow(t *testing.T) {
+	viper.Set("verbose", true)
+	defer viper.Set("verbose", false)
+
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "default", want: "Created window: 6_months_ago (default) (created on or after "},
+		{name: "explicit", args: []string{"--newer-than", "2_weeks_ago"}, want: "Created window: 2_weeks_ago (created on or after "},
+		{name: "all time", args: []string{"--newer-than", "all_time"}, want: "Created window: all_time (no date filter)\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newIssueFilterCmd(t, tt.args...)
+			var filter *api.IssueFilter
+			_, stderr := captureOutput(t, func() { filter = buildIssueFilterTyped(cmd) })
+			if stderr != "" {
+				t.Errorf("buildIssueFilterTyped() wrote %q to stderr, want nothing", stderr)
+			}
+
+			_, stderr = captureOutput(t, func() { printCreatedWindow(cmd, filter) })
+			if !strings.HasPrefix(stderr, tt.want) || strings.Count(stderr, "\n") != 1 {
+				t.Errorf("printCreatedWindow() = %q, want one line starting %q", stderr, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalStateNames(t *testing.T) {
+	valid := []string{"Backlog", "Todo", "In Progress", "Done"}
+
+	got, err := canonicalStateNames("ENG", []string{"todo", "IN PROGRESS"}, valid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(got) != "[Todo In Progress]" {
+		t.Errorf("got %q, want [Todo In Progress]", got)
+	}
+
+	_, err = canonicalStateNames("ENG", []string{"Todo", "Doing"}, valid)
+	if err == nil {
+		t.Fatal("expected an error for an unknown state")
+	}
+	want := `unknown state "Doing" for team ENG; valid states: Backlog, Todo, In Progress, Done`
+	if err.Error() != want {
+		t.Errorf("error = %q, want %q", err, want)
+	}
+}
+
+func TestResolveWorkflowState(t *testing.T) {
+	type state = api.IssueDetailFieldsTeamStatesWorkflowStateConnectionNodesWorkflowState
+	// A team fixture where positions do not follow the list order
+	states := []*state{
+		{Id: "s1", Name: "Backlog", Type: "backlog", Position: 0},
+		{Id: "s2", Name: "Todo", Type: "unstarted", Position: 1},
+		{Id: "s3", Name: "In Review", Type: "started", Position: 3},
+		{Id: "s4", Name: "In Progress", Type: "started", Position: 2},
+		{Id: "s5", Name: "Started", Type: "unstarted", Position: 4},
+		{Id: "s6", Name: "Done", Type: "completed", Position: 5},
+	}
+
+	tests := []struct {
+		name       string
+		input      string
+		wantID     string
+		wantByType bool
+	}{
+		{name: "exact name", input: "In Review", wantID: "s3"},
+		{name: "name is case-insensitive", input: "in progress", wantID: "s4"},
+		{name: "name wins over type keyword", input: "started", wantID: "s5"},
+		{name: "type keyword picks lowest position", input: "completed", wantID: "s6", wantByType: true},
+		{name: "type keyword trims and lowercases", input: " BACKLOG ", wantID: "s1", wantByType: true},
+		{name: "type with no state in team", input: "canceled"},
+		{name: "unknown word", input: "Blocked"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, byType := resolveWorkflowState(states, tt.input)
+			if tt.wantID == "" {
+				if got != nil {
+					t.Errorf("resolveWorkflowState(%q) = %s, want nil", tt.input, got.Name)
+				}
+				return
+			}
+			if got == nil {
+				t.Fatalf("resolveWorkflowState(%q) = nil, want %s", tt.input, tt.wantID)
+			}
+			if got.Id != tt.wantID || byType != tt.wantByType {
+				t.Errorf("resolveWorkflowState(%q) = %s, byType %v; want %s, byType %v", tt.input, got.Id, byType, tt.wantID, tt.wantByType)
+			}
+		})
+	}
+
+	// The same lookup works for states from GetTeamStates
+	teamStates := []*api.GetTeamStatesTeamStatesWorkflowStateConnectionNodesWorkflowState{
+		{Id: "t1", Name: "Doing", Type: "started", Position: 2},
+		{Id: "t2", Name: "Working", Type: "started", Position: 1},
+	}
+	if got, byType := resolveWorkflowState(teamStates, "started"); got == nil || got.Id != "t2" || !byType {
+		t.Errorf("resolveWorkflowState over team states = %+v, %v; want t2 by type", got, byType)
+	}
+}
+
+func TestIssueJSONWithState(t *testing.T) {
+	issue := &api.UpdateIssueIssueUpdateIssuePayloadIssue{IssueListFields: api.IssueListFields{Identifier: "LIN-1"}}
+	state := &resolvedState{ID: "s3", Name: "In Progress", Type: "started", MatchedByType: true}
+
+	if got := issueJSONWithState(issue, nil); got != issue {
+		t.Errorf("without a state the issue should be returned unchanged, got %v", got)
+	}
+
+	data, err := json.Marshal(issueJSONWithState(issue, state))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result map[string]interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		t.Fatalf("invalid JSON %s: %v", data, err)
+	}
+	if result["identifier"] != "LIN-1" {
+		t.Errorf("identifier = %v, want LIN-1", result["identifier"])
+	}
+	want := map[string]interface{}{"id": "s3", "name": "In Progress", "type": "started", "matchedByType": true}
+	if fmt.Sprint(result["resolvedState"]) != fmt.Sprint(want) {
+		t.Errorf("resolvedState = %v, want %v", result["resolvedState"], want)
+	}
+
+	var ok bool
+	stdout, _ := captureOutput(t, func() {
+		ok = reportUpdateWithComment("LIN-1", issue, state, nil, nil, false, true)
+	})
+	if !ok || !strings.Contains(stdout, `"resolvedState"`) {
+		t.Errorf("comment result is missing resolvedState: %s", stdout)
+	}
+}
+
+func TestFormatStateGlyphRenderersAgree(t *testing.T) {
+	origNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = origNoColor }()
+	defer viper.Set("ascii", false)
+
+	tests := []struct {
+		stateType string
+		checkbox  string
+		icon      string
+	}{
+		{"completed", "[x]", "✓"},
+		{"done", "[x]", "✓"},
+		{"started", "[~]", "◐"},
+		{"in_progress", "[~]", "◐"},
+		{"canceled", "[-]", "✗"},
+		{"cancelled", "[-]", "✗"},
+		{"triage", "[ ]", "○"},
+		{"backlog", "[ ]", "○"},
+		{"unstarted", "[ ]", "○"},
+		{"", "[ ]", "○"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.stateType, func(t *testing.T) {
+			viper.Set("ascii", false)
+			plain := formatStateGlyph(tt.stateType, true)
+			rich := formatStateGlyph(tt.stateType, false)
+
+			viper.Set("ascii", true)
+			plainASCII := formatStateGlyph(tt.stateType, true)
+			richASCII := formatStateGlyph(tt.stateType, false)
+
+			if plain != tt.checkbox || plainASCII != tt.checkbox {
+				t.Errorf("plaintext = %q (ascii %q), want %q", plain, plainASCII, tt.checkbox)
+			}
+			if rich != tt.icon {
+				t.Errorf("rich = %q, want %q", rich, tt.icon)
+			}
+			// Under --ascii both renderers must show the same marker
+			if richASCII != plainASCII {
+				t.Errorf("rich --ascii = %q, plaintext = %q; want them to agree", richASCII, plainASCII)
+			}
+		})
+	}
+}
+
+// responderIssue builds a first-response fixture: an issue created by creator
+// with one comment per commenter ("" for a comment without a user, such as
+// one synced from an integration)
+func responderIssue(id, creator string, commenters ...string) *api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue {
+	issue := &api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{
+		IssueListFields: api.IssueListFields{Id: id},
+		Comments:        &api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection{},
+	}
+	if creator != "" {
+		issue.Creator = &api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser{Id: creator}
+	}
+	for _, commenter := range commenters {
+		comment := &api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment{}
+		if commenter != "" {
+			comment.User = &api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser{Id: commenter}
+		}
+		issue.Comments.Nodes = append(issue.Comments.Nodes, comment)
+	}
+	return issue
+}
+
+func TestAwaitingFirstResponse(t *testing.T) {
+	tests := []struct {
+		name  string
+		issue *api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue
+		want  bool
+	}{
+		{name: "no comments", issue: responderIssue("1", "customer"), want: true},
+		{name: "comments not fetched", issue: &api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{}, want: true},
+		{name: "only the creator commented", issue: responderIssue("1", "customer", "customer", "customer"), want: true},
+		{name: "integration comment", issue: responderIssue("1", "customer", ""), want: true},
+		{name: "team member replied", issue: responderIssue("1", "customer", "customer", "agent"), want: false},
+		{name: "team member replied after an integration", issue: responderIssue("1", "customer", "", "agent"), want: false},
+		{name: "no creator, any user comment counts", issue: responderIssue("1", "", "agent"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := awaitingFirstResponse(tt.issue); got != tt.want {
+				t.Errorf("awaitingFirstResponse = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeResponderPages serves the given pages in order
+func fakeResponderPages(pages ...[]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) (responderPageFetcher, *int) {
+	calls := 0
+	fetch := func(ctx context.Context, filter *api.IssueFilter, first int, after *string) (*api.ListIssuesWithRespondersIssuesIssueConnection, error) {
+		page := pages[calls]
+		calls++
+		conn := &api.ListIssuesWithRespondersIssuesIssueConnection{
+			Nodes:    page,
+			PageInfo: &api.ListIssuesWithRespondersIssuesIssueConnectionPageInfo{},
+		}
+		if calls < len(pages) {
+			cursor := fmt.Sprintf("page%d", calls)
+			conn.PageInfo.HasNextPage = true
+			conn.PageInfo.EndCursor = &cursor
+		}
+		return conn, nil
+	}
+	return fetch, &calls
+}
+
+func TestCollectFirstResponsePending(t *testing.T) {
+	pending := func(id string) *api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue {
+		return responderIssue(id, "customer")
+	}
+	answered := func(id string) *api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue {
+		return responderIssue(id, "customer", "agent")
+	}
+
+	tests := []struct {
+		name      string
+		pages     [][]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue
+		limit     int
+		want      string
+		wantNext  bool
+		wantCalls int
+	}{
+		{
+			name:      "limit hit on the last pending issue of the last page",
+			pages:     [][]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{{pending("a"), answered("b"), pending("c"), answered("d")}},
+			limit:     2,
+			want:      "a,c",
+			wantNext:  false,
+			wantCalls: 1,
+		},
+		{
+			name:      "limit hit with more pending issues on the page",
+			pages:     [][]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{{pending("a"), pending("b"), pending("c")}},
+			limit:     2,
+			want:      "a,b",
+			wantNext:  true,
+			wantCalls: 1,
+		},
+		{
+			name:      "limit hit with more pages",
+			pages:     [][]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{{pending("a"), answered("b")}, {pending("c")}},
+			limit:     1,
+			want:      "a",
+			wantNext:  true,
+			wantCalls: 1,
+		},
+		{
+			name:      "fewer pending than limit across pages",
+			pages:     [][]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{{answered("a"), pending("b")}, {pending("c"), answered("d")}},
+			limit:     5,
+			want:      "b,c",
+			wantNext:  false,
+			wantCalls: 2,
+		},
+		{
+			name:      "all pages, shifted issue returned once",
+			pages:     [][]*api.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue{{pending("a"), pending("b")}, {pending("b"), pending("c")}},
+			limit:     0,
+			want:      "a,b,c",
+			wantNext:  false,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fetch, calls := fakeResponderPages(tt.pages...)
+			filter := &api.IssueFilter{}
+			issues, hasNext, err := collectFirstResponsePending(context.Background(), filter, fetch, tt.limit)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var ids []string
+			for _, issue := range issues {
+				ids = append(ids, issue.Id)
+			}
+			if got := strings.Join(ids, ","); got != tt.want {
+				t.Errorf("issues = %s, want %s", got, tt.want)
+			}
+			if hasNext != tt.wantNext {
+				t.Errorf("hasNextPage = %v, want %v", hasNext, tt.wantNext)
+			}
+			if *calls != tt.wantCalls {
+				t.Errorf("fetched %d pages, want %d", *calls, tt.wantCalls)
+			}
+
+			// Only exports (no limit) snapshot the result set
+			hasCeiling := filter.CreatedAt != nil && filter.CreatedAt.Lte != nil
+			if hasCeiling != (tt.limit <= 0) {
+				t.Errorf("createdAt ceiling set = %v, want %v", hasCeiling, tt.limit <= 0)
+			}
+		})
+	}
+}
+
+func TestLabelNameFilter(t *testing.T) {
+	filter := labelNameFilter("team-1", []string{"Bug", " needs review "})
+	if len(filter.And) != 2 {
+		t.Fatalf("And = %+v, want [scope, names]", filter.And)
+	}
+
+	scope := filter.And[0].Or
+	if len(scope) != 2 || scope[0].Team == nil || scope[0].Team.Id == nil || *scope[0].Team.Id.Eq != "team-1" {
+		t.Errorf("scope = %+v, want team-1 or workspace", scope)
+	}
+	if len(scope) == 2 && (scope[1].Team == nil || scope[1].Team.Null == nil || !*scope[1].Team.Null) {
+		t.Errorf("scope[1] = %+v, want workspace labels", scope[1])
+	}
+
+	var names []string
+	for _, f := range filter.And[1].Or {
+		if f.Name == nil || f.Name.EqIgnoreCase == nil {
+			t.Fatalf("name filter = %+v, want eqIgnoreCase", f)
+		}
+		names = append(names, *f.Name.EqIgnoreCase)
+	}
+	if fmt.Sprint(names) != "[Bug needs review]" {
+		t.Errorf("names = %q, want [Bug needs review]", names)
+	}
+}
+
+func TestMatchLabelIDs(t *testing.T) {
+	team := &api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam{Id: "team-1", Key: "ENG"}
+	label := func(id, name string, team *api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) *api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel {
+		return &api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel{Id: id, Name: name, Team: team}
+	}
+
+	tests := []struct {
+		name    string
+		labels  []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel
+		names   []string
+		want    []string
+		wantErr string
+	}{
+		{
+			name:   "case-insensitive",
+			labels: []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel{label("l1", "Bug", nil), label("l2", "Needs Review", team)},
+			names:  []string{"needs review", " BUG "},
+			want:   []string{"l2", "l1"},
+		},
+		{
+			name:   "team label after workspace label",
+			labels: []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel{label("ws", "Bug", nil), label("team", "bug", team)},
+			names:  []string{"Bug"},
+			want:   []string{"team"},
+		},
+		{
+			name:   "team label before workspace label",
+			labels: []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel{label("team", "Bug", team), label("ws", "Bug", nil)},
+			names:  []string{"Bug"},
+			want:   []string{"team"},
+		},
+		{
+			name:    "every missing name reported",
+			labels:  []*api.ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel{label("l1", "Bug", nil)},
+			names:   []string{"Feature", "Bug", "Chore"},
+			wantErr: "labels not found: Feature, Chore",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := matchLabelIDs(tt.labels, tt.names)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("matchLabelIDs() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("matchLabelIDs() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// newIssueInputCmd builds a command with the issue create (or update) flags
+// that --input-file fills in, with --input-file pointing at a temp file
+// holding content
+func newIssueInputCmd(t *testing.T, create bool, content string, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{}
+	cmd.Flags().String("title", "", "")
+	cmd.Flags().StringP("description", "d", "", "")
+	cmd.Flags().StringP("assignee", "a", "", "")
+	cmd.Flags().String("input-file", "", "")
+	if create {
+		cmd.Flags().StringP("team", "t", "", "")
+		cmd.Flags().Int("priority", 3, "")
+		cmd.Flags().BoolP("assign-me", "m", false, "")
+		cmd.Flags().Bool("no-assignee", false, "")
+	} else {
+		cmd.Flags().StringP("state", "s", "", "")
+		cmd.Flags().Int("priority", -1, "")
+		cmd.Flags().String("due-date", "", "")
+	}
+
+	if content != "" {
+		path := filepath.Join(t.TempDir(), "issue.json")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		args = append(args, "--input-file", path)
+	}
+	if err := cmd.ParseFlags(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestReadIssueInputFile(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+		check   func(*issueInputFile) bool
+	}{
+		{name: "no input file", check: func(f *issueInputFile) bool { return f == nil }},
+		{
+			name:    "known keys",
+			content: `{"title": "Fix login", "teamKey": "ENG", "priority": 2, "labels": ["Bug"]}`,
+			check: func(f *issueInputFile) bool {
+				return *f.Title == "Fix login" && *f.TeamKey == "ENG" && *f.Priority == 2 && fmt.Sprint(*f.Labels) == "[Bug]"
+			},
+		},
+		{
+			name:    "null fields are unset",
+			content: `{"title": null, "priority": null, "labels": null, "teamKey": "ENG"}`,
+			check: func(f *issueInputFile) bool {
+				return f.Title == nil && f.Priority == nil && f.Labels == nil && *f.TeamKey == "ENG"
+			},
+		},
+		{name: "not an object", content: `["title"]`, wantErr: "must contain a JSON object"},
+		{name: "wrong type", content: `{"priority": "high"}`, wantErr: "invalid input file"},
+		{name: "empty title", content: `{"title": " "}`, wantErr: "title cannot be empty"},
+		{name: "two assignees", content: `{"assignee": "me", "assigneeEmail": "a@b.c"}`, wantErr: "either assignee or assigneeEmail"},
+		{name: "priority out of range", content: `{"priority": 5}`, wantErr: "priority must be between 0 and 4"},
+		{name: "bad due date", content: `{"dueDate": "tomorrow"}`, wantErr: "dueDate must use YYYY-MM-DD"},
+		{name: "empty label", content: `{"labels": ["Bug", ""]}`, wantErr: "labels cannot contain empty names"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			file, err := readIssueInputFile(newIssueInputCmd(t, true, tt.content))
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("readIssueInputFile() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !tt.check(file) {
+				t.Errorf("readIssueInputFile() = %+v, unexpected fields", file)
+			}
+		})
+	}
+}
+
+func TestReadIssueInputFileUnknownKeys(t *testing.T) {
+	_, err := readIssueInputFile(newIssueInputCmd(t, true, `{"title": "x", "zeta": 1, "assigne": "me"}`))
+	if err == nil || !strings.Contains(err.Error(), ": assigne, zeta (supported: title,") {
+		t.Fatalf("readIssueInputFile() error = %v, want both unknown keys listed in order", err)
+	}
+}
+
+func TestIssueInputFileApplyToFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		create  bool
+		content string
+		args    []string
+		want    map[string]string
+	}{
+		{
+			name:    "file fills unset flags",
+			create:  true,
+			content: `{"title": "Fix login", "description": "Steps", "teamKey": "ENG", "priority": 1, "assignee": "me"}`,
+			want:    map[string]string{"title": "Fix login", "description": "Steps", "team": "ENG", "priority": "1", "assignee": "me"},
+		},
+		{
+			name:    "flags win over the file",
+			create:  true,
+			content: `{"title": "From file", "teamKey": "ENG", "priority": 1}`,
+			args:    []string{"--title", "From flag", "--priority", "2"},
+			want:    map[string]string{"title": "From flag", "team": "ENG", "priority": "2"},
+		},
+		{
+			name:    "null fields leave flags unset",
+			create:  true,
+			content: `{"title": null, "description": null, "priority": null, "teamKey": "ENG"}`,
+			want:    map[string]string{"title": "", "description": "", "priority": "3", "team": "ENG"},
+		},
+		{
+			name:    "assigneeEmail sets assignee",
+			create:  true,
+			content: `{"assigneeEmail": "a@b.c"}`,
+			want:    map[string]string{"assignee": "a@b.c"},
+		},
+		{
+			name:    "assign-me drops the file assignee",
+			create:  true,
+			content: `{"assignee": "a@b.c"}`,
+			args:    []string{"--assign-me"},
+			want:    map[string]string{"assignee": ""},
+		},
+		{
+			name:    "update fields",
+			content: `{"state": "In Review", "dueDate": "2026-11-01", "priority": 0}`,
+			args:    []string{"--state", "Done"},
+			want:    map[string]string{"state": "Done", "due-date": "2026-11-01", "priority": "0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newIssueInputCmd(t, tt.create, tt.content, tt.args...)
+			file, err := readIssueInputFile(cmd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := file.applyToFlags(cmd); err != nil {
+				t.Fatal(err)
+			}
+			for flag, want := range tt.want {
+				if got := cmd.Flags().Lookup(flag).Value.String(); got != want {
+					t.Errorf("--%s = %q, want %q", flag, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckCreateRequired(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		args    []string
+		wantErr string
+	}{
+		{name: "file without title", content: `{"teamKey": "ENG"}`, wantErr: "Title is required"},
+		{name: "null title", content: `{"title": null, "teamKey": "ENG"}`, wantErr: "Title is required"},
+		{name: "file without team", content: `{"title": "Fix login"}`, wantErr: "Team is required"},
+		{name: "title from flag", content: `{"teamKey": "ENG"}`, args: []string{"--title", "Fix login"}},
+		{name: "complete file", content: `{"title": "Fix login", "teamKey": "ENG"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := newIssueInputCmd(t, true, tt.content, tt.args...)
+			file, err := readIssueInputFile(cmd)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := file.applyToFlags(cmd); err != nil {
+				t.Fatal(err)
+			}
+			err = checkCreateRequired(cmd)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("checkCreateRequired() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("checkCreateRequired() = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveLabelIDsUnknownLabel(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				Filter api.IssueLabelFilter `json:"filter"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		if and := req.Variables.Filter.And; len(and) == 2 {
+			for _, f := range and[1].Or {
+				requested = append(requested, *f.Name.EqIgnoreCase)
+			}
+		}
+		// Only "Bug" exists
+		fmt.Fprint(w, `{"data": {"issueLabels": {"nodes": [{"id": "l1", "name": "Bug", "team": null}]}}}`)
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "test")
+
+	ids, err := resolveLabelIDs(context.Background(), client, "team-1", []string{"bug"})
+	if err != nil || fmt.Sprint(ids) != "[l1]" {
+		t.Fatalf("resolveLabelIDs() = %q, %v, want [l1]", ids, err)
+	}
+
+	_, err = resolveLabelIDs(context.Background(), client, "team-1", []string{"Bug", "Regression"})
+	if err == nil || err.Error() != "labels not found: Regression" {
+		t.Fatalf("resolveLabelIDs() error = %v, want labels not found: Regression", err)
+	}
+	if fmt.Sprint(requested) != "[bug Bug Regression]" {
+		t.Errorf("requested names = %q, want [bug Bug Regression]", requested)
+	}
+}
+
+func TestBlockedIssuesFrom(t *testing.T) {
+	var nodes []*api.ListBlockedIssuesIssuesIssueConnectionNodesIssue
+	fixture := `[
+		{"identifier": "ENG-1", "title": "Ship it", "url": "u1", "state": {"name": "In Progress", "type": "started"},
+		 "inverseRelations": {"nodes": [
+			{"type": "blocks", "issue": {"identifier": "ENG-2", "title": "API", "url": "u2", "state": {"name": "Todo", "type": "unstarted"}, "assignee": {"name": "Jane"}}},
+			{"type": "blocks", "issue": {"identifier": "ENG-3", "title": "Docs", "url": "u3", "state": {"name": "Done", "type": "completed"}}},
+			{"type": "related", "issue": {"identifier": "ENG-4", "title": "Related", "url": "u4", "state": {"name": "Todo", "type": "unstarted"}}},
+			{"type": "blocks", "issue": {"identifier": "ENG-5", "title": "Review", "url": "u5", "state": {"name": "In Review", "type": "started"}}}
+		 ]}},
+		{"identifier": "ENG-6", "title": "Unblocked", "url": "u6", "state": {"name": "Todo", "type": "unstarted"},
+		 "inverseRelations": {"nodes": [
+			{"type": "blocks", "issue": {"identifier": "ENG-7", "title": "Done", "url": "u7", "state": {"name": "Done", "type": "completed"}}},
+			{"type": "blocks", "issue": {"identifier": "ENG-8", "title": "Dropped", "url": "u8", "state": {"name": "Canceled", "type": "canceled"}}}
+		 ]}}
+	]`
+	if err := json.Unmarshal([]byte(fixture), &nodes); err != nil {
+		t.Fatal(err)
+	}
+
+	blocked := blockedIssuesFrom(nodes)
+	if len(blocked) != 1 || blocked[0].Identifier != "ENG-1" || blocked[0].State != "In Progress" {
+		t.Fatalf("blockedIssuesFrom() = %+v, want only ENG-1; ENG-6 has no open blockers", blocked)
+	}
+	want := []issueBlocker{
+		{Identifier: "ENG-2", Title: "API", State: "Todo", StateType: "unstarted", Assignee: "Jane", URL: "u2", Stalled: true},
+		{Identifier: "ENG-5", Title: "Review", State: "In Review", StateType: "started", URL: "u5"},
+	}
+	if fmt.Sprint(blocked[0].Blockers) != fmt.Sprint(want) {
+		t.Errorf("Blockers = %+v, want %+v", blocked[0].Blockers, want)
+	}
+}
+
+func TestPrintBlockedIssues(t *testing.T) {
+	blocked := []blockedIssue{{
+		Identifier: "ENG-1", Title: "Ship it", State: "In Progress", URL: "u1",
+		Blockers: []issueBlocker{
+			{Identifier: "ENG-2", Title: "API", State: "Todo", StateType: "unstarted", Assignee: "Jane", URL: "u2", Stalled: true},
+			{Identifier: "ENG-5", Title: "Review", State: "In Review", StateType: "started", URL: "u5"},
+		},
+	}}
+
+	t.Run("json", func(t *testing.T) {
+		stdout, _ := captureOutput(t, func() { printBlockedIssues(blocked, false, true) })
+		var got []map[string]interface{}
+		if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+			t.Fatalf("output is not a JSON array: %v\n%s", err, stdout)
+		}
+		if len(got) != 1 || got[0]["identifier"] != "ENG-1" || got[0]["state"] != "In Progress" {
+			t.Fatalf("issues = %v, want ENG-1", got)
+		}
+		blockers, _ := got[0]["blockers"].([]interface{})
+		if len(blockers) != 2 {
+			t.Fatalf("blockers = %v, want 2", got[0]["blockers"])
+		}
+		first := blockers[0].(map[string]interface{})
+		if first["stalled"] != true || first["assignee"] != "Jane" || first["stateType"] != "unstarted" {
+			t.Errorf("blockers[0] = %v, want stalled, assigned to Jane", first)
+		}
+		if _, ok := blockers[1].(map[string]interface{})["assignee"]; ok {
+			t.Errorf("blockers[1] = %v, want no assignee key when unassigned", blockers[1])
+		}
+	})
+
+	t.Run("json empty", func(t *testing.T) {
+		stdout, _ := captureOutput(t, func() { printBlockedIssues(nil, false, true) })
+		if strings.TrimSpace(stdout) != "[]" {
+			t.Errorf("output = %q, want []", stdout)
+		}
+	})
+
+	t.Run("plaintext", func(t *testing.T) {
+		viper.Set("plaintext", true)
+		defer viper.Set("plaintext", false)
+		stdout, _ := captureOutput(t, func() { printBlockedIssues(blocked, true, false) })
+		want := "# Blocked Issues\n" +
+			"\n## ENG-1: Ship it [In Progress]\n" +
+			"- Blocked by ENG-2: API [Todo] (Jane) (not started)\n" +
+			"- Blocked by ENG-5: Review [In Review] (Unassigned)\n" +
+			"\nTotal: 1 blocked issues\n"
+		if stdout != want {
+			t.Errorf("output =\n%s\nwant\n%s", stdout, want)
+		}
+	})
+}
+
+func TestResolveUserID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Variables struct {
+				Filter api.UserFilter `json:"filter"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		// Match like the API would: "jane" is only a display name
+		for _, f := range req.Variables.Filter.Or {
+			if (f.DisplayName != nil && *f.DisplayName.Eq == "jane") ||
+				(f.Id != nil && *f.Id.Eq == "0b5c6f3e-8d3a-4c1e-9f2a-1234567890ab") {
+				fmt.Fprint(w, `{"data": {"users": {"nodes": [{"id": "user-1"}]}}}`)
+				return
+			}
+		}
+		fmt.Fprint(w, `{"data": {"users": {"nodes": []}}}`)
+	}))
+	defer server.Close()
+	client := api.NewClientWithURL(server.URL, "test")
+
+	for _, user := range []string{"jane", "0b5c6f3e-8d3a-4c1e-9f2a-1234567890ab"} {
+		if id, err := resolveUserID(context.Background(), client, user); err != nil || id != "user-1" {
+			t.Errorf("resolveUserID(%q) = %q, %v, want user-1", user, id, err)
+		}
+	}
+	if _, err := resolveUserID(context.Background(), client, "nobody@example.com"); err == nil || !strings.Contains(err.Error(), "user not found") {
+		t.Errorf("resolveUserID(unknown) error = %v, want user not found", err)
+	}
+}
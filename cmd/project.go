@@ -1,7 +1,6 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -99,12 +98,17 @@ var projectListCmd = &cobra.Command{
 		}
 
 		// Get projects
-		resp, err := api.ListProjects(context.Background(), client, &filterTyped, limitPtr, nil, orderByEnum)
+		resp, err := api.ListProjects(cmd.Context(), client, &filterTyped, limitPtr, nil, orderByEnum)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 
+		if summaryOnly() {
+			printSummary(len(resp.Projects.Nodes), "projects", "")
+			return
+		}
+
 		// Handle output
 		if jsonOut {
 			output.JSON(resp.Projects.Nodes)
@@ -116,7 +120,7 @@ var projectListCmd = &cobra.Command{
 				fmt.Printf("## %s\n", f.Name)
 				fmt.Printf("- **ID**: %s\n", f.Id)
 				fmt.Printf("- **State**: %s\n", f.State)
-				fmt.Printf("- **Progress**: %.0f%%\n", f.Progress*100)
+				fmt.Printf("- **Progress**: %s\n", numberFormat().Percent(f.Progress))
 				if f.Lead != nil {
 					fmt.Printf("- **Lead**: %s\n", f.Lead.Name)
 				} else {
@@ -146,7 +150,7 @@ var projectListCmd = &cobra.Command{
 				}
 				fmt.Println()
 			}
-			fmt.Printf("\nTotal: %d projects\n", len(resp.Projects.Nodes))
+			printSummary(len(resp.Projects.Nodes), "projects", "")
 			return
 		} else {
 			// Table output
@@ -201,11 +205,7 @@ var projectListCmd = &cobra.Command{
 				Rows:    rows,
 			}, plaintext, jsonOut)
 
-			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d projects\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(resp.Projects.Nodes))
-			}
+			printSummary(len(resp.Projects.Nodes), "projects", "")
 		}
 	},
 }
@@ -232,7 +232,7 @@ var projectGetCmd = &cobra.Command{
 		client := api.NewClient(authHeader)
 
 		// Get project details
-		resp, err := api.GetProject(context.Background(), client, projectID)
+		resp, err := api.GetProject(cmd.Context(), client, projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -258,7 +258,7 @@ var projectGetCmd = &cobra.Command{
 			fmt.Printf("- **ID**: %s\n", f.Id)
 			fmt.Printf("- **Slug ID**: %s\n", f.SlugId)
 			fmt.Printf("- **State**: %s\n", f.State)
-			fmt.Printf("- **Progress**: %.0f%%\n", f.Progress*100)
+			fmt.Printf("- **Progress**: %s\n", numberFormat().Percent(f.Progress))
 			if f.Health != nil {
 				fmt.Printf("- **Health**: %s\n", *f.Health)
 			}
@@ -372,34 +372,28 @@ var projectGetCmd = &cobra.Command{
 					if doc.Icon != nil && *doc.Icon != "" {
 						fmt.Printf("- **Icon**: %s\n", *doc.Icon)
 					}
-					fmt.Printf("- **Color**: %s\n", doc.Color)
+					if doc.Color != nil && *doc.Color != "" {
+						fmt.Printf("- **Color**: %s\n", *doc.Color)
+					}
 					fmt.Printf("- **Created**: %s by %s\n", doc.CreatedAt.Format("2006-01-02"), doc.Creator.Name)
 					if doc.UpdatedBy != nil {
 						fmt.Printf("- **Updated**: %s by %s\n", doc.UpdatedAt.Format("2006-01-02"), doc.UpdatedBy.Name)
 					}
-					fmt.Printf("\n%s\n", doc.Content)
+					if doc.Content != nil {
+						fmt.Printf("\n%s\n", *doc.Content)
+					}
 				}
 			}
 
 			// Show recent issues
 			if f.Issues != nil && len(f.Issues.Nodes) > 0 {
-				fmt.Printf("\n## Issues (%d total)\n", len(f.Issues.Nodes))
+				fmt.Printf("\n## Issues (%s total)\n", numberFormat().Count(len(f.Issues.Nodes)))
 				for _, issue := range f.Issues.Nodes {
-					stateStr := ""
+					stateType := ""
 					if issue.State != nil {
-						switch issue.State.Type {
-						case "completed":
-							stateStr = "[x]"
-						case "started":
-							stateStr = "[~]"
-						case "canceled":
-							stateStr = "[-]"
-						default:
-							stateStr = "[ ]"
-						}
-					} else {
-						stateStr = "[ ]"
+						stateType = issue.State.Type
 					}
+					stateStr := formatStateGlyph(stateType, true)
 
 					assignee := "Unassigned"
 					if issue.Assignee != nil {
@@ -409,9 +403,9 @@ var projectGetCmd = &cobra.Command{
 					fmt.Printf("\n### %s %s (#%.0f)\n", stateStr, issue.Identifier, issue.Number)
 					fmt.Printf("**%s**\n", issue.Title)
 					fmt.Printf("- Assignee: %s\n", assignee)
-					fmt.Printf("- Priority: %s\n", priorityToString(int(issue.Priority)))
+					fmt.Printf("- Priority: %s\n", formatPriority(int(issue.Priority), false))
 					if issue.Estimate != nil {
-						fmt.Printf("- Estimate: %.1f\n", *issue.Estimate)
+						fmt.Printf("- Estimate: %s\n", numberFormat().Fixed(*issue.Estimate, 1))
 					}
 					if issue.State != nil {
 						fmt.Printf("- State: %s\n", issue.State.Name)
@@ -476,7 +470,7 @@ var projectGetCmd = &cobra.Command{
 			} else if f.Progress >= 0.5 {
 				progressColor = color.New(color.FgYellow)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Progress:"), progressColor.Sprintf("%.0f%%", f.Progress*100))
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Progress:"), progressColor.Sprint(numberFormat().Percent(f.Progress)))
 
 			if f.StartDate != nil || f.TargetDate != nil {
 				fmt.Println()
@@ -521,17 +515,11 @@ var projectGetCmd = &cobra.Command{
 					if i >= 5 {
 						break // Show only first 5
 					}
-					stateIcon := "○"
+					stateType := ""
 					if issue.State != nil {
-						switch issue.State.Type {
-						case "completed":
-							stateIcon = color.New(color.FgGreen).Sprint("✓")
-						case "started":
-							stateIcon = color.New(color.FgBlue).Sprint("◐")
-						case "canceled":
-							stateIcon = color.New(color.FgRed).Sprint("✗")
-						}
+						stateType = issue.State.Type
 					}
+					stateIcon := formatStateGlyph(stateType, false)
 					assignee := "Unassigned"
 					if issue.Assignee != nil {
 						assignee = issue.Assignee.Name
@@ -1,20 +1,35 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/shanedolley/lincli/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-    cfgFile   string
-    plaintext bool
-    jsonOut   bool
+    cfgFile            string
+    plaintext          bool
+    jsonOut            bool
+    quiet              bool
+    verbose            bool
+    noColor            bool
+    noEmoji            bool
+    ascii              bool
+    requireConfirm     bool
+    locale             string
+    pageDelay          time.Duration
+    noSummary          bool
+    summaryOnlyFlag    bool
 )
 
 // version is set at build time via -ldflags
@@ -75,7 +90,16 @@ var rootCmd = &cobra.Command{
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 func Execute() {
-	err := rootCmd.Execute()
+	// Ctrl-C cancels the command's context, which aborts in-flight API calls
+	// and stops paginated and bulk operations instead of waiting out
+	// --page-delay. A second Ctrl-C gets the default behavior and exits.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	err := rootCmd.ExecuteContext(ctx)
+	stop()
 	if err != nil {
 		os.Exit(1)
 	}
@@ -107,6 +131,38 @@ func migrateOldConfig() {
 	}
 }
 
+// numberFormat returns the formatter for counts, estimates, and percentages.
+// Only the --locale flag (or locale config key) turns on localized output;
+// LC_ALL and LC_NUMERIC are ignored so the default formatting never changes
+// behind the user's back.
+func numberFormat() utils.NumberFormat {
+	return utils.NewNumberFormat(viper.GetString("locale"))
+}
+
+// waitPageDelay sleeps for --page-delay between successive API calls in
+// paginated and bulk operations. It returns early with the context's error
+// if the context is canceled while waiting.
+func waitPageDelay(ctx context.Context) error {
+	delay := viper.GetDuration("page-delay")
+	if delay <= 0 {
+		return ctx.Err()
+	}
+	return sleepContext(ctx, delay)
+}
+
+// sleepContext waits for d or until ctx is done. It is a variable so tests
+// can replace the clock.
+var sleepContext = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 func init() {
 	migrateOldConfig()
 	cobra.OnInitialize(initConfig)
@@ -115,10 +171,31 @@ func init() {
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.lincli.yaml)")
 	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (non-interactive)")
 	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "suppress informational notes")
+	rootCmd.PersistentFlags().BoolVar(&verbose, "verbose", false, "show extra detail such as effective filters")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output")
+	rootCmd.PersistentFlags().BoolVar(&noEmoji, "no-emoji", false, "disable emoji icons in output")
+	rootCmd.PersistentFlags().BoolVar(&ascii, "ascii", false, "use ASCII-only markers such as [x] instead of icons")
+	rootCmd.PersistentFlags().BoolVar(&requireConfirm, "confirm-destructive", false, "always prompt before destructive operations, even with --yes")
+	rootCmd.PersistentFlags().BoolVar(&noSummary, "no-summary", false, "omit the total line printed after lists")
+	rootCmd.PersistentFlags().BoolVar(&summaryOnlyFlag, "summary-only", false, "print only the total line of lists")
+	rootCmd.MarkFlagsMutuallyExclusive("no-summary", "summary-only")
+	rootCmd.PersistentFlags().DurationVar(&pageDelay, "page-delay", 0, "wait this long between paginated or bulk API calls, e.g. 500ms")
+	rootCmd.PersistentFlags().StringVar(&locale, "locale", "", "locale for number formatting, e.g. de or fr_FR (default: unlocalized)")
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("plaintext", rootCmd.PersistentFlags().Lookup("plaintext"))
 	_ = viper.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json"))
+	_ = viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet"))
+	_ = viper.BindPFlag("verbose", rootCmd.PersistentFlags().Lookup("verbose"))
+	_ = viper.BindPFlag("no-color", rootCmd.PersistentFlags().Lookup("no-color"))
+	_ = viper.BindPFlag("no-emoji", rootCmd.PersistentFlags().Lookup("no-emoji"))
+	_ = viper.BindPFlag("ascii", rootCmd.PersistentFlags().Lookup("ascii"))
+	_ = viper.BindPFlag("require_confirmation", rootCmd.PersistentFlags().Lookup("confirm-destructive"))
+	_ = viper.BindPFlag("locale", rootCmd.PersistentFlags().Lookup("locale"))
+	_ = viper.BindPFlag("no-summary", rootCmd.PersistentFlags().Lookup("no-summary"))
+	_ = viper.BindPFlag("summary-only", rootCmd.PersistentFlags().Lookup("summary-only"))
+	_ = viper.BindPFlag("page-delay", rootCmd.PersistentFlags().Lookup("page-delay"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -139,6 +216,10 @@ func initConfig() {
 
 	viper.AutomaticEnv() // read in environment variables that match
 
+	if viper.GetBool("no-color") {
+		color.NoColor = true
+	}
+
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
 		if !plaintext && !jsonOut {
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/shanedolley/lincli/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// fakeSleep replaces sleepContext for the test, recording each requested delay
+func fakeSleep(t *testing.T) *[]time.Duration {
+	t.Helper()
+	var delays []time.Duration
+	orig := sleepContext
+	sleepContext = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return ctx.Err()
+	}
+	t.Cleanup(func() { sleepContext = orig })
+	return &delays
+}
+
+func TestPageDelayAppliedBetweenPages(t *testing.T) {
+	tests := []struct {
+		name  string
+		delay time.Duration
+		pages int
+		want  int
+	}{
+		{name: "three pages", delay: 250 * time.Millisecond, pages: 3, want: 2},
+		{name: "single page", delay: 250 * time.Millisecond, pages: 1, want: 0},
+		{name: "no delay", delay: 0, pages: 3, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("page-delay", tt.delay)
+			defer viper.Set("page-delay", time.Duration(0))
+			delays := fakeSleep(t)
+
+			pages := map[string][]string{}
+			next := map[string]string{}
+			cursor := ""
+			for i := 0; i < tt.pages; i++ {
+				pages[cursor] = []string{string(rune('a' + i))}
+				if i < tt.pages-1 {
+					next[cursor] = cursor + "x"
+					cursor += "x"
+				}
+			}
+			fetch, _ := fakeIssuePages(t, pages, next)
+
+			fetched := 0
+			err := forEachFetchedIssuePage(context.Background(), &api.IssueFilter{}, fetch, func(page []*api.ListIssuesIssuesIssueConnectionNodesIssue) error {
+				fetched += len(page)
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if fetched != tt.pages {
+				t.Errorf("fetched %d issues, want %d", fetched, tt.pages)
+			}
+			if len(*delays) != tt.want {
+				t.Fatalf("slept %d times, want %d", len(*delays), tt.want)
+			}
+			for _, d := range *delays {
+				if d != tt.delay {
+					t.Errorf("slept %v, want %v", d, tt.delay)
+				}
+			}
+		})
+	}
+}
+
+func TestPageDelayStopsOnCancel(t *testing.T) {
+	viper.Set("page-delay", time.Hour)
+	defer viper.Set("page-delay", time.Duration(0))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	if err := waitPageDelay(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("waitPageDelay = %v, want context.Canceled", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("waitPageDelay took %v after cancellation", elapsed)
+	}
+
+	// Paging stops at the first delay once the context is canceled
+	fakeSleep(t)
+	fetch, filters := fakeIssuePages(t,
+		map[string][]string{"": {"a"}, "p2": {"b"}},
+		map[string]string{"": "p2"},
+	)
+	err := forEachFetchedIssuePage(ctx, &api.IssueFilter{}, fetch, func([]*api.ListIssuesIssuesIssueConnectionNodesIssue) error { return nil })
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("forEachFetchedIssuePage = %v, want context.Canceled", err)
+	}
+	if len(*filters) != 1 {
+		t.Errorf("fetched %d pages after cancellation, want 1", len(*filters))
+	}
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/shanedolley/lincli/pkg/output"
+	"github.com/spf13/viper"
+)
+
+// summaryOnly reports whether --summary-only was given, in which case list
+// commands print just their total via printSummary and skip the listing
+func summaryOnly() bool {
+	return viper.GetBool("summary-only")
+}
+
+// printSummary prints the total footer of a list command, e.g. "Total: 12
+// issues" in plaintext or "✓ 12 issues" in rich output. Footers are never
+// printed in JSON mode or under --no-summary; with --summary-only the total
+// is printed on its own, as {"total": N} for JSON.
+func printSummary(count int, noun, detail string) {
+	writeSummary(count, noun, detail, true)
+}
+
+// printTotal is printSummary for lists whose rich footer reads "Total: 12
+// issues" like their plaintext one, such as issue list and issue search
+func printTotal(count int, noun string) {
+	writeSummary(count, noun, "", false)
+}
+
+func writeSummary(count int, noun, detail string, richCheck bool) {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
+
+	if summaryOnly() {
+		if jsonOut {
+			output.JSON(map[string]int{"total": count})
+			return
+		}
+	} else if jsonOut || viper.GetBool("no-summary") {
+		return
+	} else {
+		fmt.Println()
+	}
+
+	line := numberFormat().Count(count) + " " + noun
+	if detail != "" {
+		line += " " + detail
+	}
+	if plaintext || !richCheck {
+		fmt.Printf("Total: %s\n", line)
+	} else {
+		fmt.Printf("%s %s\n", color.New(color.FgGreen).Sprint("✓"), line)
+	}
+}
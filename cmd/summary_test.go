@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/spf13/viper"
+)
+
+func TestPrintSummary(t *testing.T) {
+	origNoColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = origNoColor }()
+
+	tests := []struct {
+		name        string
+		plaintext   bool
+		jsonOut     bool
+		noSummary   bool
+		summaryOnly bool
+		total       bool
+		want        string
+	}{
+		{name: "rich", want: "\n✓ 3 issues\n"},
+		{name: "rich total", total: true, want: "\nTotal: 3 issues\n"},
+		{name: "plaintext", plaintext: true, want: "\nTotal: 3 issues\n"},
+		{name: "plaintext total", plaintext: true, total: true, want: "\nTotal: 3 issues\n"},
+		{name: "json", jsonOut: true, want: ""},
+		{name: "no-summary rich", noSummary: true, want: ""},
+		{name: "no-summary rich total", noSummary: true, total: true, want: ""},
+		{name: "no-summary plaintext", plaintext: true, noSummary: true, want: ""},
+		{name: "no-summary plaintext total", plaintext: true, noSummary: true, total: true, want: ""},
+		{name: "summary-only", summaryOnly: true, want: "✓ 3 issues\n"},
+		{name: "summary-only plaintext", plaintext: true, summaryOnly: true, want: "Total: 3 issues\n"},
+		{name: "summary-only json", jsonOut: true, summaryOnly: true, want: "{\n  \"total\": 3\n}\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Set("plaintext", tt.plaintext)
+			viper.Set("json", tt.jsonOut)
+			viper.Set("no-summary", tt.noSummary)
+			viper.Set("summary-only", tt.summaryOnly)
+			defer func() {
+				for _, key := range []string{"plaintext", "json", "no-summary", "summary-only"} {
+					viper.Set(key, false)
+				}
+			}()
+
+			stdout, _ := captureOutput(t, func() {
+				if tt.total {
+					printTotal(3, "issues")
+				} else {
+					printSummary(3, "issues", "")
+				}
+			})
+			if stdout != tt.want {
+				t.Errorf("output = %q, want %q", stdout, tt.want)
+			}
+		})
+	}
+}
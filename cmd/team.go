@@ -1,9 +1,9 @@
 package cmd
 
 import (
-	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/shanedolley/lincli/pkg/api"
@@ -23,7 +23,8 @@ var teamCmd = &cobra.Command{
 Examples:
   lincli team list              # List all teams
   lincli team get ENG           # Get team details
-  lincli team members ENG       # List team members`,
+  lincli team members ENG       # List team members
+  lincli team workload ENG      # Open issues and estimates per assignee`,
 }
 
 var teamListCmd = &cobra.Command{
@@ -75,12 +76,17 @@ var teamListCmd = &cobra.Command{
 		}
 
 		// Get teams
-		resp, err := api.ListTeams(context.Background(), client, limitPtr, nil, orderByEnum)
+		resp, err := api.ListTeams(cmd.Context(), client, limitPtr, nil, orderByEnum)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list teams: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 
+		if summaryOnly() {
+			printSummary(len(resp.Teams.Nodes), "teams", "")
+			return
+		}
+
 		// Handle output
 		if jsonOut {
 			output.JSON(resp.Teams.Nodes)
@@ -130,7 +136,7 @@ var teamListCmd = &cobra.Command{
 					f.Name,
 					description,
 					privateStr,
-					fmt.Sprintf("%d", f.IssueCount),
+					numberFormat().Count(f.IssueCount),
 				})
 			}
 
@@ -139,21 +145,48 @@ var teamListCmd = &cobra.Command{
 				Rows:    rows,
 			}, plaintext, jsonOut)
 
-			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d teams\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(resp.Teams.Nodes))
-			}
+			printSummary(len(resp.Teams.Nodes), "teams", "")
 		}
 	},
 }
 
+// openIssueCountLimit caps the open issue count in team get --show-issues,
+// so large teams are counted with one request instead of paging every issue
+const openIssueCountLimit = 250
+
+// formatOpenIssueCount formats a capped open issue count, e.g. "250+" when
+// more issues match than were counted
+func formatOpenIssueCount(count int, more bool) string {
+	if more {
+		return numberFormat().Count(count) + "+"
+	}
+	return numberFormat().Count(count)
+}
+
+// addShowIssuesFlag registers team get --show-issues. The count is optional,
+// so it must be joined with "=": "--show-issues 25" reads 25 as a second
+// argument.
+func addShowIssuesFlag(cmd *cobra.Command) {
+	cmd.Flags().Int("show-issues", 0, "Include the N most recently updated open issues; use --show-issues=N (default 10 when given without a value)")
+	cmd.Flags().Lookup("show-issues").NoOptDefVal = "10"
+}
+
 var teamGetCmd = &cobra.Command{
 	Use:     "get TEAM-KEY",
 	Aliases: []string{"show"},
 	Short:   "Get team details",
-	Long:    `Get detailed information about a specific team.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get detailed information about a specific team.
+
+Use --show-issues to also list the team's most recently updated open issues
+and its open issue count, shown as "250+" past 250. Pass a number with "="
+to change how many issues are shown; "--show-issues 25" is rejected because
+25 is read as a second team key.
+
+Examples:
+  lincli team get ENG
+  lincli team get ENG --show-issues
+  lincli team get ENG --show-issues=25`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -170,15 +203,51 @@ var teamGetCmd = &cobra.Command{
 		client := api.NewClient(authHeader)
 
 		// Get team details
-		resp, err := api.GetTeam(context.Background(), client, teamKey)
+		resp, err := api.GetTeam(cmd.Context(), client, teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 		team := resp.Team.TeamDetailFields
 
+		// Optionally fetch the team's most recently updated open issues
+		showIssues, _ := cmd.Flags().GetInt("show-issues")
+		var issues []*api.ListIssuesIssuesIssueConnectionNodesIssue
+		openCount, moreOpen := 0, false
+		if showIssues > 0 {
+			filter := &api.IssueFilter{
+				Team:  &api.TeamFilter{Key: stringEq(team.Key)},
+				State: &api.WorkflowStateFilter{Type: stringNin([]string{"completed", "canceled"})},
+			}
+			orderBy := api.PaginationOrderByUpdatedat
+			issuesResp, err := api.ListIssues(cmd.Context(), client, filter, &showIssues, nil, &orderBy)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get team issues: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+			issues = issuesResp.Issues.Nodes
+
+			openCount, moreOpen, err = countIssuesUpTo(cmd.Context(), client, filter, openIssueCountLimit)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to count open issues: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
 		// Handle output
 		if jsonOut {
+			if showIssues > 0 {
+				if issues == nil {
+					issues = []*api.ListIssuesIssuesIssueConnectionNodesIssue{}
+				}
+				output.JSON(struct {
+					api.TeamDetailFields
+					OpenIssueCount       int                                              `json:"openIssueCount"`
+					OpenIssueCountCapped bool                                             `json:"openIssueCountCapped,omitempty"`
+					RecentIssues         []*api.ListIssuesIssuesIssueConnectionNodesIssue `json:"recentIssues"`
+				}{team, openCount, moreOpen, issues})
+				return
+			}
 			output.JSON(team)
 		} else if plaintext {
 			fmt.Printf("Key: %s\n", team.Key)
@@ -187,7 +256,12 @@ var teamGetCmd = &cobra.Command{
 				fmt.Printf("Description: %s\n", *team.Description)
 			}
 			fmt.Printf("Private: %v\n", team.Private)
-			fmt.Printf("Issue Count: %d\n", team.IssueCount)
+			fmt.Printf("Issue Count: %s\n", numberFormat().Count(team.IssueCount))
+			if showIssues > 0 {
+				fmt.Printf("Open Issues: %s\n\n", formatOpenIssueCount(openCount, moreOpen))
+				// Nested under the team details rather than a new document
+				printIssueList("Recent Issues", 2, issues, true, false)
+			}
 		} else {
 			// Formatted output
 			fmt.Println()
@@ -208,7 +282,16 @@ var teamGetCmd = &cobra.Command{
 				privateStr = color.New(color.FgYellow).Sprint("🔒 Yes")
 			}
 			fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("Private:"), privateStr)
-			fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Total Issues:"), team.IssueCount)
+			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Total Issues:"), numberFormat().Count(team.IssueCount))
+			if showIssues > 0 {
+				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Open Issues:"), formatOpenIssueCount(openCount, moreOpen))
+				fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Recent Issues:"))
+				if len(issues) == 0 {
+					fmt.Println("  (none)")
+				} else {
+					printIssueList("Recent Issues", 2, issues, false, false)
+				}
+			}
 			fmt.Println()
 		}
 	},
@@ -235,13 +318,18 @@ var teamMembersCmd = &cobra.Command{
 		client := api.NewClient(authHeader)
 
 		// Get team members
-		resp, err := api.GetTeamMembers(context.Background(), client, teamKey)
+		resp, err := api.GetTeamMembers(cmd.Context(), client, teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team members: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 		members := resp.Team.Members.Nodes
 
+		if summaryOnly() {
+			printSummary(len(members), "members", "in team "+teamKey)
+			return
+		}
+
 		// Handle output
 		if jsonOut {
 			output.JSON(members)
@@ -294,13 +382,204 @@ var teamMembersCmd = &cobra.Command{
 				Rows:    rows,
 			}, plaintext, jsonOut)
 
-			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d members in team %s\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(members),
-					color.New(color.FgCyan).Sprint(teamKey))
+			printSummary(len(members), "members", "in team "+color.New(color.FgCyan).Sprint(teamKey))
+		}
+	},
+}
+
+// assigneeWorkload summarizes the open issues assigned to one person
+type assigneeWorkload struct {
+	Assignee      string  `json:"assignee"`
+	Email         string  `json:"email,omitempty"`
+	Open          int     `json:"open"`
+	Estimated     int     `json:"estimated"`
+	Unestimated   int     `json:"unestimated"`
+	TotalEstimate float64 `json:"totalEstimate"`
+}
+
+// issueBucket is a group of issues that share a key, such as an assignee
+type issueBucket struct {
+	Key    string
+	Label  string
+	Issues []*api.ListIssuesIssuesIssueConnectionNodesIssue
+}
+
+// bucketIssues groups issues by the key and label returned from keyFn,
+// keeping buckets in the order their first issue was seen
+func bucketIssues(issues []*api.ListIssuesIssuesIssueConnectionNodesIssue, keyFn func(*api.ListIssuesIssuesIssueConnectionNodesIssue) (string, string)) []*issueBucket {
+	buckets := []*issueBucket{}
+	byKey := make(map[string]*issueBucket)
+	for _, issue := range issues {
+		key, label := keyFn(issue)
+		bucket, ok := byKey[key]
+		if !ok {
+			bucket = &issueBucket{Key: key, Label: label}
+			byKey[key] = bucket
+			buckets = append(buckets, bucket)
+		}
+		bucket.Issues = append(bucket.Issues, issue)
+	}
+	return buckets
+}
+
+// summarizeWorkload aggregates issues per assignee, heaviest load first
+func summarizeWorkload(issues []*api.ListIssuesIssuesIssueConnectionNodesIssue) []assigneeWorkload {
+	buckets := bucketIssues(issues, func(issue *api.ListIssuesIssuesIssueConnectionNodesIssue) (string, string) {
+		if issue.Assignee == nil {
+			return "", "Unassigned"
+		}
+		return issue.Assignee.Id, issue.Assignee.Name
+	})
+
+	workloads := make([]assigneeWorkload, 0, len(buckets))
+	for _, bucket := range buckets {
+		w := assigneeWorkload{Assignee: bucket.Label, Open: len(bucket.Issues)}
+		if first := bucket.Issues[0]; first.Assignee != nil {
+			w.Email = first.Assignee.Email
+		}
+		for _, issue := range bucket.Issues {
+			if issue.Estimate == nil {
+				w.Unestimated++
+				continue
 			}
+			w.Estimated++
+			w.TotalEstimate += *issue.Estimate
+		}
+		workloads = append(workloads, w)
+	}
+
+	sort.SliceStable(workloads, func(i, j int) bool {
+		if workloads[i].Open != workloads[j].Open {
+			return workloads[i].Open > workloads[j].Open
+		}
+		if workloads[i].TotalEstimate != workloads[j].TotalEstimate {
+			return workloads[i].TotalEstimate > workloads[j].TotalEstimate
 		}
+		return workloads[i].Assignee < workloads[j].Assignee
+	})
+	return workloads
+}
+
+var teamWorkloadCmd = &cobra.Command{
+	Use:   "workload TEAM-KEY",
+	Short: "Show open issues and estimates per assignee",
+	Long: `Summarize a team's open (not completed or canceled) issues per assignee,
+including unassigned work, sorted by the number of open issues.
+
+Issues without an estimate are counted separately so they don't skew the
+estimate totals.
+
+Examples:
+  lincli team workload ENG
+  lincli team workload ENG --cycle current
+  lincli team workload ENG --cycle next
+  lincli team workload ENG --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		teamKey := strings.ToUpper(args[0])
+
+		filter := &api.IssueFilter{
+			Team:  &api.TeamFilter{Key: stringEq(teamKey)},
+			State: &api.WorkflowStateFilter{Type: stringNin([]string{"completed", "canceled"})},
+		}
+
+		// Get auth header
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		// Create API client
+		client := api.NewClient(authHeader)
+
+		cycle, _ := cmd.Flags().GetString("cycle")
+		if cycle != "" {
+			filter.Cycle, err = cycleFilter(cmd.Context(), client, teamKey, cycle)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
+		issues, err := fetchAllIssues(cmd.Context(), client, filter, nil)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		workloads := summarizeWorkload(issues)
+
+		scope := func(team string) string {
+			if cycle != "" {
+				return "in team " + team + " in cycle " + cycle
+			}
+			return "in team " + team
+		}
+		if summaryOnly() {
+			printSummary(len(issues), "open issues", scope(teamKey))
+			return
+		}
+
+		// Handle output
+		if jsonOut {
+			output.JSON(workloads)
+			return
+		}
+
+		nf := numberFormat()
+		if plaintext {
+			fmt.Println("Assignee\tEmail\tOpen\tEstimated\tUnestimated\tTotal Estimate")
+			for _, w := range workloads {
+				fmt.Printf("%s\t%s\t%s\t%s\t%s\t%s\n",
+					w.Assignee,
+					w.Email,
+					nf.Count(w.Open),
+					nf.Count(w.Estimated),
+					nf.Count(w.Unestimated),
+					nf.Decimal(w.TotalEstimate),
+				)
+			}
+			return
+		}
+
+		if len(workloads) == 0 {
+			fmt.Printf("\n%s No open issues in team %s\n",
+				color.New(color.FgYellow).Sprint("ℹ️"),
+				color.New(color.FgCyan).Sprint(teamKey))
+			return
+		}
+
+		headers := []string{"Assignee", "Open", "Estimate", "Unestimated"}
+		rows := [][]string{}
+		for _, w := range workloads {
+			name := w.Assignee
+			if w.Email == "" {
+				name = color.New(color.FgYellow).Sprint(name)
+			}
+			unestimated := nf.Count(w.Unestimated)
+			if w.Unestimated > 0 {
+				unestimated = color.New(color.FgYellow).Sprint(unestimated)
+			}
+			rows = append(rows, []string{
+				name,
+				nf.Count(w.Open),
+				nf.Decimal(w.TotalEstimate),
+				unestimated,
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		team := teamKey
+		if !plaintext {
+			team = color.New(color.FgCyan).Sprint(teamKey)
+		}
+		printSummary(len(issues), "open issues", scope(team))
 	},
 }
 
@@ -309,8 +588,15 @@ func init() {
 	teamCmd.AddCommand(teamListCmd)
 	teamCmd.AddCommand(teamGetCmd)
 	teamCmd.AddCommand(teamMembersCmd)
+	teamCmd.AddCommand(teamWorkloadCmd)
 
 	// List command flags
 	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return")
 	teamListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+
+	// Get command flags
+	addShowIssuesFlag(teamGetCmd)
+
+	// Workload command flags
+	teamWorkloadCmd.Flags().String("cycle", "", "Limit to a cycle: number, current/active, or next/upcoming")
 }
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shanedolley/lincli/pkg/api"
+	"github.com/spf13/cobra"
+)
+
+// workloadIssue is an open issue for the given assignee ID ("" for
+// unassigned) with an optional estimate
+func workloadIssue(id, assigneeID string, estimate *float64) *api.ListIssuesIssuesIssueConnectionNodesIssue {
+	issue := issueNode(id)
+	issue.Estimate = estimate
+	if assigneeID != "" {
+		issue.Assignee = &api.IssueListFieldsAssigneeUser{
+			Id:    assigneeID,
+			Name:  "User " + assigneeID,
+			Email: assigneeID + "@example.com",
+		}
+	}
+	return issue
+}
+
+func estimate(points float64) *float64 {
+	return &points
+}
+
+func TestBucketIssues(t *testing.T) {
+	issues := []*api.ListIssuesIssuesIssueConnectionNodesIssue{
+		workloadIssue("1", "b", nil),
+		workloadIssue("2", "", nil),
+		workloadIssue("3", "a", nil),
+		workloadIssue("4", "b", nil),
+	}
+	buckets := bucketIssues(issues, func(issue *api.ListIssuesIssuesIssueConnectionNodesIssue) (string, string) {
+		if issue.Assignee == nil {
+			return "", "Unassigned"
+		}
+		return issue.Assignee.Id, issue.Assignee.Name
+	})
+
+	var got []string
+	for _, bucket := range buckets {
+		ids := ""
+		for _, issue := range bucket.Issues {
+			ids += issue.Id
+		}
+		got = append(got, bucket.Label+":"+ids)
+	}
+	// Buckets keep first-seen order, issues keep input order
+	if want := "[User b:14 Unassigned:2 User a:3]"; fmt.Sprint(got) != want {
+		t.Errorf("bucketIssues() = %v, want %s", got, want)
+	}
+}
+
+func TestSummarizeWorkload(t *testing.T) {
+	issues := []*api.ListIssuesIssuesIssueConnectionNodesIssue{
+		workloadIssue("1", "a", estimate(3)),
+		workloadIssue("2", "", nil),
+		workloadIssue("3", "b", estimate(1)),
+		workloadIssue("4", "a", nil),
+		workloadIssue("5", "b", estimate(2)),
+		workloadIssue("6", "", estimate(5)),
+		workloadIssue("7", "c", estimate(8)),
+		workloadIssue("8", "d", estimate(0.5)),
+	}
+
+	// a, b and Unassigned have two issues each and are ordered by total
+	// estimate; c and d have one each
+	want := []assigneeWorkload{
+		{Assignee: "Unassigned", Open: 2, Estimated: 1, Unestimated: 1, TotalEstimate: 5},
+		{Assignee: "User a", Email: "a@example.com", Open: 2, Estimated: 1, Unestimated: 1, TotalEstimate: 3},
+		{Assignee: "User b", Email: "b@example.com", Open: 2, Estimated: 2, TotalEstimate: 3},
+		{Assignee: "User c", Email: "c@example.com", Open: 1, Estimated: 1, TotalEstimate: 8},
+		{Assignee: "User d", Email: "d@example.com", Open: 1, Estimated: 1, TotalEstimate: 0.5},
+	}
+
+	got := summarizeWorkload(issues)
+	if len(got) != len(want) {
+		t.Fatalf("summarizeWorkload() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("workload[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestSummarizeWorkloadEmpty(t *testing.T) {
+	if got := summarizeWorkload(nil); got == nil || len(got) != 0 {
+		t.Errorf("summarizeWorkload(nil) = %#v, want an empty slice", got)
+	}
+}
+
+func TestCountIssuesUpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		matching int
+		want     int
+		wantMore bool
+	}{
+		{name: "under the limit", matching: 3, want: 3},
+		{name: "at the limit", matching: 5, want: 5},
+		{name: "over the limit", matching: 12, want: 5, wantMore: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				var req struct {
+					Variables struct {
+						First int `json:"first"`
+					} `json:"variables"`
+				}
+				if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+					t.Errorf("decode request: %v", err)
+				}
+				n := tt.matching
+				if n > req.Variables.First {
+					n = req.Variables.First
+				}
+				nodes := make([]map[string]string, n)
+				for i := range nodes {
+					nodes[i] = map[string]string{"id": fmt.Sprint(i)}
+				}
+				_ = json.NewEncoder(w).Encode(map[string]interface{}{"data": map[string]interface{}{"issues": map[string]interface{}{
+					"nodes":    nodes,
+					"pageInfo": map[string]interface{}{"hasNextPage": tt.matching > n, "endCursor": "c"},
+				}}})
+			}))
+			defer server.Close()
+
+			count, more, err := countIssuesUpTo(context.Background(), api.NewClientWithURL(server.URL, "test"), &api.IssueFilter{}, 5)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if count != tt.want || more != tt.wantMore {
+				t.Errorf("countIssuesUpTo() = %d, %v, want %d, %v", count, more, tt.want, tt.wantMore)
+			}
+			if requests != 1 {
+				t.Errorf("made %d requests, want 1", requests)
+			}
+		})
+	}
+}
+
+func TestFormatOpenIssueCount(t *testing.T) {
+	if got := formatOpenIssueCount(42, false); got != "42" {
+		t.Errorf("formatOpenIssueCount(42, false) = %q, want 42", got)
+	}
+	if got := formatOpenIssueCount(openIssueCountLimit, true); got != "250+" {
+		t.Errorf("formatOpenIssueCount(250, true) = %q, want 250+", got)
+	}
+}
+
+func TestShowIssuesFlag(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		want     int
+		wantArgs bool
+	}{
+		{name: "not given", args: []string{"ENG"}, want: 0, wantArgs: true},
+		{name: "no value", args: []string{"ENG", "--show-issues"}, want: 10, wantArgs: true},
+		{name: "joined value", args: []string{"ENG", "--show-issues=25"}, want: 25, wantArgs: true},
+		// NoOptDefVal leaves a space-separated count as a second team key
+		{name: "space-separated value", args: []string{"ENG", "--show-issues", "25"}, want: 10, wantArgs: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Args: cobra.ExactArgs(1)}
+			addShowIssuesFlag(cmd)
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatal(err)
+			}
+			if got, _ := cmd.Flags().GetInt("show-issues"); got != tt.want {
+				t.Errorf("--show-issues = %d, want %d", got, tt.want)
+			}
+			if err := cmd.ValidateArgs(cmd.Flags().Args()); (err == nil) != tt.wantArgs {
+				t.Errorf("ValidateArgs(%q) = %v, want valid: %v", cmd.Flags().Args(), err, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestPrintIssueListNested(t *testing.T) {
+	issue := issueNode("1")
+	issue.Identifier = "ENG-1"
+	issue.Title = "Fix login"
+	issue.Url = "https://linear.app/acme/issue/ENG-1"
+	issue.CreatedAt = time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	stdout, _ := captureOutput(t, func() {
+		printIssueList("Recent Issues", 2, []*api.ListIssuesIssuesIssueConnectionNodesIssue{issue}, true, false)
+	})
+	want := "## Recent Issues\n" +
+		"### Fix login\n" +
+		"- **ID**: ENG-1\n" +
+		"- **Assignee**: Unassigned\n" +
+		"- **Created**: 2026-10-01\n" +
+		"- **URL**: https://linear.app/acme/issue/ENG-1\n\n"
+	if stdout != want {
+		t.Errorf("output =\n%s\nwant\n%s", stdout, want)
+	}
+}
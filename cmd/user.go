@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/shanedolley/lincli/pkg/api"
@@ -76,7 +77,7 @@ var userListCmd = &cobra.Command{
 		}
 
 		// Get users
-		resp, err := api.ListUsers(context.Background(), client, limitPtr, nil, orderByEnum)
+		resp, err := api.ListUsers(cmd.Context(), client, limitPtr, nil, orderByEnum)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list users: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -94,6 +95,11 @@ var userListCmd = &cobra.Command{
 			filteredUsers = activeUsers
 		}
 
+		if summaryOnly() {
+			printSummary(len(filteredUsers), "users", "")
+			return
+		}
+
 		// Handle output
 		if jsonOut {
 			// Extract just the fields for JSON output
@@ -153,11 +159,7 @@ var userListCmd = &cobra.Command{
 				Rows:    rows,
 			}, plaintext, jsonOut)
 
-			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d users\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(filteredUsers))
-			}
+			printSummary(len(filteredUsers), "users", "")
 		}
 	},
 }
@@ -188,7 +190,7 @@ var userGetCmd = &cobra.Command{
 			Email: &api.StringComparator{Eq: &email},
 		}
 
-		userResp, err := api.GetUserByEmail(context.Background(), client, filter)
+		userResp, err := api.GetUserByEmail(cmd.Context(), client, filter)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get user: %v", err), plaintext, jsonOut)
 			os.Exit(1)
@@ -271,15 +273,33 @@ var userMeCmd = &cobra.Command{
 		client := api.NewClient(authHeader)
 
 		// Get current user
-		resp, err := api.GetViewer(context.Background(), client)
+		resp, err := api.GetViewer(cmd.Context(), client)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
 			os.Exit(1)
 		}
 		user := resp.Viewer.UserDetailFields
 
+		// Optionally fetch team memberships
+		showTeams, _ := cmd.Flags().GetBool("teams")
+		var teams []viewerTeam
+		if showTeams {
+			teams, err = fetchViewerTeams(cmd.Context(), client)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get teams: %v", err), plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
+
 		// Handle output
 		if jsonOut {
+			if showTeams {
+				output.JSON(struct {
+					api.UserDetailFields
+					Teams []viewerTeam `json:"teams"`
+				}{user, teams})
+				return
+			}
 			output.JSON(user)
 		} else if plaintext {
 			fmt.Printf("ID: %s\n", user.Id)
@@ -290,6 +310,9 @@ var userMeCmd = &cobra.Command{
 			if user.AvatarUrl != nil && *user.AvatarUrl != "" {
 				fmt.Printf("Avatar: %s\n", *user.AvatarUrl)
 			}
+			if showTeams {
+				printViewerTeams(teams, true)
+			}
 		} else {
 			// Formatted output
 			fmt.Println()
@@ -320,11 +343,90 @@ var userMeCmd = &cobra.Command{
 				fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Avatar:"),
 					color.New(color.FgBlue).Sprint(*user.AvatarUrl))
 			}
+			if showTeams {
+				printViewerTeams(teams, false)
+			}
 			fmt.Println()
 		}
 	},
 }
 
+// viewerTeam is a team membership of the current user
+type viewerTeam struct {
+	ID      string `json:"id"`
+	Key     string `json:"key"`
+	Name    string `json:"name"`
+	Private bool   `json:"private"`
+	Admin   bool   `json:"admin"`
+}
+
+// fetchViewerTeams returns the current user's team memberships sorted by key
+func fetchViewerTeams(ctx context.Context, client *api.Client) ([]viewerTeam, error) {
+	first := 250
+	resp, err := api.GetViewerTeams(ctx, client, &first)
+	if err != nil {
+		return nil, err
+	}
+
+	teams := []viewerTeam{}
+	if resp.Viewer.TeamMemberships == nil {
+		return teams, nil
+	}
+	for _, membership := range resp.Viewer.TeamMemberships.Nodes {
+		if membership.Team == nil {
+			continue
+		}
+		teams = append(teams, viewerTeam{
+			ID:      membership.Team.Id,
+			Key:     membership.Team.Key,
+			Name:    membership.Team.Name,
+			Private: membership.Team.Private,
+			Admin:   membership.Owner,
+		})
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Key < teams[j].Key })
+	return teams, nil
+}
+
+// printViewerTeams renders the current user's teams for plaintext or rich output
+func printViewerTeams(teams []viewerTeam, plaintext bool) {
+	if plaintext {
+		fmt.Println("\nTeams:")
+		fmt.Println("Key\tName\tRole")
+		for _, team := range teams {
+			role := "Member"
+			if team.Admin {
+				role = "Admin"
+			}
+			fmt.Printf("%s\t%s\t%s\n", team.Key, team.Name, role)
+		}
+		return
+	}
+
+	fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Teams:"))
+	if len(teams) == 0 {
+		fmt.Println("  (none)")
+		return
+	}
+	headers := []string{"Key", "Name", "Role"}
+	rows := [][]string{}
+	for _, team := range teams {
+		role := color.New(color.FgWhite).Sprint("Member")
+		if team.Admin {
+			role = color.New(color.FgYellow).Sprint("Admin")
+		}
+		rows = append(rows, []string{
+			color.New(color.FgCyan, color.Bold).Sprint(team.Key),
+			team.Name,
+			role,
+		})
+	}
+	output.Table(output.TableData{
+		Headers: headers,
+		Rows:    rows,
+	}, false, false)
+}
+
 func init() {
 	rootCmd.AddCommand(userCmd)
 	userCmd.AddCommand(userListCmd)
@@ -335,4 +437,7 @@ func init() {
 	userListCmd.Flags().IntP("limit", "l", 50, "Maximum number of users to return")
 	userListCmd.Flags().BoolP("active", "a", false, "Show only active users")
 	userListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+
+	// Me command flags
+	userMeCmd.Flags().Bool("teams", false, "Show your team memberships and roles")
 }
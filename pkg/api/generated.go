@@ -801,6 +801,57 @@ func (v *ContentComparator) GetContains() *string { return v.Contains }
 // GetNotContains returns ContentComparator.NotContains, and is useful for accessing the field via an interface.
 func (v *ContentComparator) GetNotContains() *string { return v.NotContains }
 
+// CountIssuesIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type CountIssuesIssuesIssueConnection struct {
+	Nodes    []*CountIssuesIssuesIssueConnectionNodesIssue `json:"nodes"`
+	PageInfo *CountIssuesIssuesIssueConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns CountIssuesIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *CountIssuesIssuesIssueConnection) GetNodes() []*CountIssuesIssuesIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// GetPageInfo returns CountIssuesIssuesIssueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *CountIssuesIssuesIssueConnection) GetPageInfo() *CountIssuesIssuesIssueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// CountIssuesIssuesIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type CountIssuesIssuesIssueConnectionNodesIssue struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns CountIssuesIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
+func (v *CountIssuesIssuesIssueConnectionNodesIssue) GetId() string { return v.Id }
+
+// CountIssuesIssuesIssueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type CountIssuesIssuesIssueConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns CountIssuesIssuesIssueConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *CountIssuesIssuesIssueConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns CountIssuesIssuesIssueConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *CountIssuesIssuesIssueConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// CountIssuesResponse is returned by CountIssues on success.
+type CountIssuesResponse struct {
+	// All issues.
+	Issues *CountIssuesIssuesIssueConnection `json:"issues"`
+}
+
+// GetIssues returns CountIssuesResponse.Issues, and is useful for accessing the field via an interface.
+func (v *CountIssuesResponse) GetIssues() *CountIssuesIssuesIssueConnection { return v.Issues }
+
 // CreateCommentCommentCreateCommentPayload includes the requested fields of the GraphQL type CommentPayload.
 type CreateCommentCommentCreateCommentPayload struct {
 	// The comment that was created or updated.
@@ -2192,6 +2243,121 @@ type GetProjectResponse struct {
 // GetProject returns GetProjectResponse.Project, and is useful for accessing the field via an interface.
 func (v *GetProjectResponse) GetProject() *GetProjectProject { return v.Project }
 
+// GetTeamCyclesResponse is returned by GetTeamCycles on success.
+type GetTeamCyclesResponse struct {
+	// One specific team.
+	Team *GetTeamCyclesTeam `json:"team"`
+}
+
+// GetTeam returns GetTeamCyclesResponse.Team, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesResponse) GetTeam() *GetTeamCyclesTeam { return v.Team }
+
+// GetTeamCyclesTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type GetTeamCyclesTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// Whether the team uses cycles.
+	CyclesEnabled bool `json:"cyclesEnabled"`
+	// Team's currently active cycle.
+	ActiveCycle *GetTeamCyclesTeamActiveCycle `json:"activeCycle"`
+	// Cycles associated with the team.
+	Cycles *GetTeamCyclesTeamCyclesCycleConnection `json:"cycles"`
+}
+
+// GetId returns GetTeamCyclesTeam.Id, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeam) GetId() string { return v.Id }
+
+// GetKey returns GetTeamCyclesTeam.Key, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeam) GetKey() string { return v.Key }
+
+// GetCyclesEnabled returns GetTeamCyclesTeam.CyclesEnabled, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeam) GetCyclesEnabled() bool { return v.CyclesEnabled }
+
+// GetActiveCycle returns GetTeamCyclesTeam.ActiveCycle, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeam) GetActiveCycle() *GetTeamCyclesTeamActiveCycle { return v.ActiveCycle }
+
+// GetCycles returns GetTeamCyclesTeam.Cycles, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeam) GetCycles() *GetTeamCyclesTeamCyclesCycleConnection { return v.Cycles }
+
+// GetTeamCyclesTeamActiveCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type GetTeamCyclesTeamActiveCycle struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The number of the cycle.
+	Number float64 `json:"number"`
+	// The custom name of the cycle.
+	Name *string `json:"name"`
+	// The start time of the cycle.
+	StartsAt time.Time `json:"startsAt"`
+	// The end time of the cycle.
+	EndsAt time.Time `json:"endsAt"`
+}
+
+// GetId returns GetTeamCyclesTeamActiveCycle.Id, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamActiveCycle) GetId() string { return v.Id }
+
+// GetNumber returns GetTeamCyclesTeamActiveCycle.Number, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamActiveCycle) GetNumber() float64 { return v.Number }
+
+// GetName returns GetTeamCyclesTeamActiveCycle.Name, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamActiveCycle) GetName() *string { return v.Name }
+
+// GetStartsAt returns GetTeamCyclesTeamActiveCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamActiveCycle) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns GetTeamCyclesTeamActiveCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamActiveCycle) GetEndsAt() time.Time { return v.EndsAt }
+
+// GetTeamCyclesTeamCyclesCycleConnection includes the requested fields of the GraphQL type CycleConnection.
+type GetTeamCyclesTeamCyclesCycleConnection struct {
+	Nodes []*GetTeamCyclesTeamCyclesCycleConnectionNodesCycle `json:"nodes"`
+}
+
+// GetNodes returns GetTeamCyclesTeamCyclesCycleConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamCyclesCycleConnection) GetNodes() []*GetTeamCyclesTeamCyclesCycleConnectionNodesCycle {
+	return v.Nodes
+}
+
+// GetTeamCyclesTeamCyclesCycleConnectionNodesCycle includes the requested fields of the GraphQL type Cycle.
+// The GraphQL type's documentation follows.
+//
+// A set of issues to be resolved in a specified amount of time.
+type GetTeamCyclesTeamCyclesCycleConnectionNodesCycle struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The number of the cycle.
+	Number float64 `json:"number"`
+	// The custom name of the cycle.
+	Name *string `json:"name"`
+	// The start time of the cycle.
+	StartsAt time.Time `json:"startsAt"`
+	// The end time of the cycle.
+	EndsAt time.Time `json:"endsAt"`
+}
+
+// GetId returns GetTeamCyclesTeamCyclesCycleConnectionNodesCycle.Id, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamCyclesCycleConnectionNodesCycle) GetId() string { return v.Id }
+
+// GetNumber returns GetTeamCyclesTeamCyclesCycleConnectionNodesCycle.Number, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamCyclesCycleConnectionNodesCycle) GetNumber() float64 { return v.Number }
+
+// GetName returns GetTeamCyclesTeamCyclesCycleConnectionNodesCycle.Name, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamCyclesCycleConnectionNodesCycle) GetName() *string { return v.Name }
+
+// GetStartsAt returns GetTeamCyclesTeamCyclesCycleConnectionNodesCycle.StartsAt, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamCyclesCycleConnectionNodesCycle) GetStartsAt() time.Time { return v.StartsAt }
+
+// GetEndsAt returns GetTeamCyclesTeamCyclesCycleConnectionNodesCycle.EndsAt, and is useful for accessing the field via an interface.
+func (v *GetTeamCyclesTeamCyclesCycleConnectionNodesCycle) GetEndsAt() time.Time { return v.EndsAt }
+
 // GetTeamMembersResponse is returned by GetTeamMembers on success.
 type GetTeamMembersResponse struct {
 	// One specific team.
@@ -2638,6 +2804,107 @@ type GetViewerResponse struct {
 // GetViewer returns GetViewerResponse.Viewer, and is useful for accessing the field via an interface.
 func (v *GetViewerResponse) GetViewer() *GetViewerViewerUser { return v.Viewer }
 
+// GetViewerTeamsResponse is returned by GetViewerTeams on success.
+type GetViewerTeamsResponse struct {
+	// The currently authenticated user.
+	Viewer *GetViewerTeamsViewerUser `json:"viewer"`
+}
+
+// GetViewer returns GetViewerTeamsResponse.Viewer, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsResponse) GetViewer() *GetViewerTeamsViewerUser { return v.Viewer }
+
+// GetViewerTeamsViewerUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type GetViewerTeamsViewerUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Memberships associated with the user. For easier access of the same data, use `teams` query.
+	TeamMemberships *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnection `json:"teamMemberships"`
+}
+
+// GetId returns GetViewerTeamsViewerUser.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUser) GetId() string { return v.Id }
+
+// GetTeamMemberships returns GetViewerTeamsViewerUser.TeamMemberships, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUser) GetTeamMemberships() *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnection {
+	return v.TeamMemberships
+}
+
+// GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnection includes the requested fields of the GraphQL type TeamMembershipConnection.
+type GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnection struct {
+	Nodes []*GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership `json:"nodes"`
+}
+
+// GetNodes returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnection) GetNodes() []*GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership {
+	return v.Nodes
+}
+
+// GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership includes the requested fields of the GraphQL type TeamMembership.
+// The GraphQL type's documentation follows.
+//
+// Defines the membership of a user to a team.
+type GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Whether the user is the owner of the team.
+	Owner bool `json:"owner"`
+	// The team that the membership is associated with.
+	Team *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam `json:"team"`
+}
+
+// GetId returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetId() string {
+	return v.Id
+}
+
+// GetOwner returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership.Owner, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetOwner() bool {
+	return v.Owner
+}
+
+// GetTeam returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership.Team, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembership) GetTeam() *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam {
+	return v.Team
+}
+
+// GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+	// The team's name.
+	Name string `json:"name"`
+	// Whether the team is private or not.
+	Private bool `json:"private"`
+}
+
+// GetId returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam.Id, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam) GetId() string {
+	return v.Id
+}
+
+// GetKey returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam.Key, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam) GetKey() string {
+	return v.Key
+}
+
+// GetName returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam.Name, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam) GetName() string {
+	return v.Name
+}
+
+// GetPrivate returns GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam.Private, and is useful for accessing the field via an interface.
+func (v *GetViewerTeamsViewerUserTeamMembershipsTeamMembershipConnectionNodesTeamMembershipTeam) GetPrivate() bool {
+	return v.Private
+}
+
 // GetViewerViewerUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
@@ -5019,6 +5286,8 @@ type IssueDetailFieldsTeam struct {
 	CycleDuration float64 `json:"cycleDuration"`
 	// How many upcoming cycles to create.
 	UpcomingCycleCount float64 `json:"upcomingCycleCount"`
+	// The issue estimation type to use. Must be one of "notUsed", "exponential", "fibonacci", "linear", "tShirt".
+	IssueEstimationType string `json:"issueEstimationType"`
 	// The states that define the workflow associated with the team.
 	States *IssueDetailFieldsTeamStatesWorkflowStateConnection `json:"states"`
 }
@@ -5053,6 +5322,9 @@ func (v *IssueDetailFieldsTeam) GetCycleDuration() float64 { return v.CycleDurat
 // GetUpcomingCycleCount returns IssueDetailFieldsTeam.UpcomingCycleCount, and is useful for accessing the field via an interface.
 func (v *IssueDetailFieldsTeam) GetUpcomingCycleCount() float64 { return v.UpcomingCycleCount }
 
+// GetIssueEstimationType returns IssueDetailFieldsTeam.IssueEstimationType, and is useful for accessing the field via an interface.
+func (v *IssueDetailFieldsTeam) GetIssueEstimationType() string { return v.IssueEstimationType }
+
 // GetStates returns IssueDetailFieldsTeam.States, and is useful for accessing the field via an interface.
 func (v *IssueDetailFieldsTeam) GetStates() *IssueDetailFieldsTeamStatesWorkflowStateConnection {
 	return v.States
@@ -6192,80 +6464,150 @@ type ListAttachmentsResponse struct {
 // GetIssue returns ListAttachmentsResponse.Issue, and is useful for accessing the field via an interface.
 func (v *ListAttachmentsResponse) GetIssue() *ListAttachmentsIssue { return v.Issue }
 
-// ListCommentsIssue includes the requested fields of the GraphQL type Issue.
+// ListBlockedIssuesIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type ListBlockedIssuesIssuesIssueConnection struct {
+	Nodes    []*ListBlockedIssuesIssuesIssueConnectionNodesIssue `json:"nodes"`
+	PageInfo *ListBlockedIssuesIssuesIssueConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns ListBlockedIssuesIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnection) GetNodes() []*ListBlockedIssuesIssuesIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListBlockedIssuesIssuesIssueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnection) GetPageInfo() *ListBlockedIssuesIssuesIssueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListBlockedIssuesIssuesIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
 // The GraphQL type's documentation follows.
 //
 // An issue.
-type ListCommentsIssue struct {
-	// Comments associated with the issue.
-	Comments *ListCommentsIssueCommentsCommentConnection `json:"comments"`
+type ListBlockedIssuesIssuesIssueConnectionNodesIssue struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// Issue URL.
+	Url string `json:"url"`
+	// The workflow state that the issue is associated with.
+	State *ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState `json:"state"`
+	// Inverse relations associated with this issue.
+	InverseRelations *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnection `json:"inverseRelations"`
 }
 
-// GetComments returns ListCommentsIssue.Comments, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssue) GetComments() *ListCommentsIssueCommentsCommentConnection {
-	return v.Comments
+// GetId returns ListBlockedIssuesIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssue) GetId() string { return v.Id }
+
+// GetIdentifier returns ListBlockedIssuesIssuesIssueConnectionNodesIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssue) GetIdentifier() string {
+	return v.Identifier
 }
 
-// ListCommentsIssueCommentsCommentConnection includes the requested fields of the GraphQL type CommentConnection.
-type ListCommentsIssueCommentsCommentConnection struct {
-	Nodes    []*ListCommentsIssueCommentsCommentConnectionNodesComment `json:"nodes"`
-	PageInfo *ListCommentsIssueCommentsCommentConnectionPageInfo       `json:"pageInfo"`
+// GetTitle returns ListBlockedIssuesIssuesIssueConnectionNodesIssue.Title, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssue) GetTitle() string { return v.Title }
+
+// GetUrl returns ListBlockedIssuesIssuesIssueConnectionNodesIssue.Url, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssue) GetUrl() string { return v.Url }
+
+// GetState returns ListBlockedIssuesIssuesIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssue) GetState() *ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState {
+	return v.State
 }
 
-// GetNodes returns ListCommentsIssueCommentsCommentConnection.Nodes, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnection) GetNodes() []*ListCommentsIssueCommentsCommentConnectionNodesComment {
+// GetInverseRelations returns ListBlockedIssuesIssuesIssueConnectionNodesIssue.InverseRelations, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssue) GetInverseRelations() *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnection {
+	return v.InverseRelations
+}
+
+// ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnection includes the requested fields of the GraphQL type IssueRelationConnection.
+type ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnection struct {
+	Nodes []*ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation `json:"nodes"`
+}
+
+// GetNodes returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnection) GetNodes() []*ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation {
 	return v.Nodes
 }
 
-// GetPageInfo returns ListCommentsIssueCommentsCommentConnection.PageInfo, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnection) GetPageInfo() *ListCommentsIssueCommentsCommentConnectionPageInfo {
-	return v.PageInfo
+// ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation includes the requested fields of the GraphQL type IssueRelation.
+// The GraphQL type's documentation follows.
+//
+// A relation between two issues.
+type ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation struct {
+	// The relationship of the issue with the related issue.
+	Type string `json:"type"`
+	// The issue whose relationship is being described.
+	Issue *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue `json:"issue"`
 }
 
-// ListCommentsIssueCommentsCommentConnectionNodesComment includes the requested fields of the GraphQL type Comment.
+// GetType returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation.Type, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation) GetType() string {
+	return v.Type
+}
+
+// GetIssue returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation.Issue, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelation) GetIssue() *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue {
+	return v.Issue
+}
+
+// ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue includes the requested fields of the GraphQL type Issue.
 // The GraphQL type's documentation follows.
 //
-// A comment associated with an issue.
-type ListCommentsIssueCommentsCommentConnectionNodesComment struct {
+// An issue.
+type ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
-	// The comment content in markdown format.
-	Body string `json:"body"`
-	// The time at which the entity was created.
-	CreatedAt time.Time `json:"createdAt"`
-	// The last time at which the entity was meaningfully updated. This is the same as the creation time if the entity hasn't
-	// been updated after creation.
-	UpdatedAt time.Time `json:"updatedAt"`
-	// The user who wrote the comment.
-	User *ListCommentsIssueCommentsCommentConnectionNodesCommentUser `json:"user"`
+	// Issue's human readable identifier (e.g. ENG-123).
+	Identifier string `json:"identifier"`
+	// The issue's title.
+	Title string `json:"title"`
+	// Issue URL.
+	Url string `json:"url"`
+	// The workflow state that the issue is associated with.
+	State *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState `json:"state"`
+	// The user to whom the issue is assigned to.
+	Assignee *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser `json:"assignee"`
 }
 
-// GetId returns ListCommentsIssueCommentsCommentConnectionNodesComment.Id, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetId() string { return v.Id }
+// GetId returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Id, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetId() string {
+	return v.Id
+}
 
-// GetBody returns ListCommentsIssueCommentsCommentConnectionNodesComment.Body, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetBody() string { return v.Body }
+// GetIdentifier returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetIdentifier() string {
+	return v.Identifier
+}
 
-// GetCreatedAt returns ListCommentsIssueCommentsCommentConnectionNodesComment.CreatedAt, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetCreatedAt() time.Time {
-	return v.CreatedAt
+// GetTitle returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Title, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetTitle() string {
+	return v.Title
 }
 
-// GetUpdatedAt returns ListCommentsIssueCommentsCommentConnectionNodesComment.UpdatedAt, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetUpdatedAt() time.Time {
-	return v.UpdatedAt
+// GetUrl returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Url, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetUrl() string {
+	return v.Url
 }
 
-// GetUser returns ListCommentsIssueCommentsCommentConnectionNodesComment.User, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetUser() *ListCommentsIssueCommentsCommentConnectionNodesCommentUser {
-	return v.User
+// GetState returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.State, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetState() *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState {
+	return v.State
 }
 
-// ListCommentsIssueCommentsCommentConnectionNodesCommentUser includes the requested fields of the GraphQL type User.
+// GetAssignee returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue.Assignee, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssue) GetAssignee() *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser {
+	return v.Assignee
+}
+
+// ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser includes the requested fields of the GraphQL type User.
 // The GraphQL type's documentation follows.
 //
 // A user that has access to the the resources of an organization.
-type ListCommentsIssueCommentsCommentConnectionNodesCommentUser struct {
+type ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser struct {
 	// The unique identifier of the entity.
 	Id string `json:"id"`
 	// The user's full name.
@@ -6274,11 +6616,203 @@ type ListCommentsIssueCommentsCommentConnectionNodesCommentUser struct {
 	Email string `json:"email"`
 }
 
-// GetId returns ListCommentsIssueCommentsCommentConnectionNodesCommentUser.Id, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesCommentUser) GetId() string { return v.Id }
+// GetId returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser.Id, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser) GetId() string {
+	return v.Id
+}
 
-// GetName returns ListCommentsIssueCommentsCommentConnectionNodesCommentUser.Name, and is useful for accessing the field via an interface.
-func (v *ListCommentsIssueCommentsCommentConnectionNodesCommentUser) GetName() string { return v.Name }
+// GetName returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser.Name, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser) GetName() string {
+	return v.Name
+}
+
+// GetEmail returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser.Email, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueAssigneeUser) GetEmail() string {
+	return v.Email
+}
+
+// ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetName returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// GetType returns ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueInverseRelationsIssueRelationConnectionNodesIssueRelationIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
+
+// ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState includes the requested fields of the GraphQL type WorkflowState.
+// The GraphQL type's documentation follows.
+//
+// A state in a team workflow.
+type ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState struct {
+	// The state's name.
+	Name string `json:"name"`
+	// The type of the state. One of "triage", "backlog", "unstarted", "started", "completed", "canceled".
+	Type string `json:"type"`
+}
+
+// GetName returns ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState.Name, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState) GetName() string {
+	return v.Name
+}
+
+// GetType returns ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState.Type, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionNodesIssueStateWorkflowState) GetType() string {
+	return v.Type
+}
+
+// ListBlockedIssuesIssuesIssueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ListBlockedIssuesIssuesIssueConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListBlockedIssuesIssuesIssueConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionPageInfo) GetHasNextPage() bool { return v.HasNextPage }
+
+// GetEndCursor returns ListBlockedIssuesIssuesIssueConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesIssuesIssueConnectionPageInfo) GetEndCursor() *string { return v.EndCursor }
+
+// ListBlockedIssuesResponse is returned by ListBlockedIssues on success.
+type ListBlockedIssuesResponse struct {
+	// All issues.
+	Issues *ListBlockedIssuesIssuesIssueConnection `json:"issues"`
+}
+
+// GetIssues returns ListBlockedIssuesResponse.Issues, and is useful for accessing the field via an interface.
+func (v *ListBlockedIssuesResponse) GetIssues() *ListBlockedIssuesIssuesIssueConnection {
+	return v.Issues
+}
+
+// ListCommentsIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type ListCommentsIssue struct {
+	// Comments associated with the issue.
+	Comments *ListCommentsIssueCommentsCommentConnection `json:"comments"`
+}
+
+// GetComments returns ListCommentsIssue.Comments, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssue) GetComments() *ListCommentsIssueCommentsCommentConnection {
+	return v.Comments
+}
+
+// ListCommentsIssueCommentsCommentConnection includes the requested fields of the GraphQL type CommentConnection.
+type ListCommentsIssueCommentsCommentConnection struct {
+	Nodes    []*ListCommentsIssueCommentsCommentConnectionNodesComment `json:"nodes"`
+	PageInfo *ListCommentsIssueCommentsCommentConnectionPageInfo       `json:"pageInfo"`
+}
+
+// GetNodes returns ListCommentsIssueCommentsCommentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnection) GetNodes() []*ListCommentsIssueCommentsCommentConnectionNodesComment {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListCommentsIssueCommentsCommentConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnection) GetPageInfo() *ListCommentsIssueCommentsCommentConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListCommentsIssueCommentsCommentConnectionNodesComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type ListCommentsIssueCommentsCommentConnectionNodesComment struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The comment content in markdown format.
+	Body string `json:"body"`
+	// The time at which the entity was created.
+	CreatedAt time.Time `json:"createdAt"`
+	// The last time at which the entity was meaningfully updated. This is the same as the creation time if the entity hasn't
+	// been updated after creation.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// The time user edited the comment.
+	EditedAt *time.Time `json:"editedAt"`
+	// The user who wrote the comment.
+	User *ListCommentsIssueCommentsCommentConnectionNodesCommentUser `json:"user"`
+	// The parent comment under which the current comment is nested.
+	Parent *ListCommentsIssueCommentsCommentConnectionNodesCommentParentComment `json:"parent"`
+}
+
+// GetId returns ListCommentsIssueCommentsCommentConnectionNodesComment.Id, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetId() string { return v.Id }
+
+// GetBody returns ListCommentsIssueCommentsCommentConnectionNodesComment.Body, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetBody() string { return v.Body }
+
+// GetCreatedAt returns ListCommentsIssueCommentsCommentConnectionNodesComment.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetCreatedAt() time.Time {
+	return v.CreatedAt
+}
+
+// GetUpdatedAt returns ListCommentsIssueCommentsCommentConnectionNodesComment.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetUpdatedAt() time.Time {
+	return v.UpdatedAt
+}
+
+// GetEditedAt returns ListCommentsIssueCommentsCommentConnectionNodesComment.EditedAt, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetEditedAt() *time.Time {
+	return v.EditedAt
+}
+
+// GetUser returns ListCommentsIssueCommentsCommentConnectionNodesComment.User, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetUser() *ListCommentsIssueCommentsCommentConnectionNodesCommentUser {
+	return v.User
+}
+
+// GetParent returns ListCommentsIssueCommentsCommentConnectionNodesComment.Parent, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesComment) GetParent() *ListCommentsIssueCommentsCommentConnectionNodesCommentParentComment {
+	return v.Parent
+}
+
+// ListCommentsIssueCommentsCommentConnectionNodesCommentParentComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type ListCommentsIssueCommentsCommentConnectionNodesCommentParentComment struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ListCommentsIssueCommentsCommentConnectionNodesCommentParentComment.Id, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesCommentParentComment) GetId() string {
+	return v.Id
+}
+
+// ListCommentsIssueCommentsCommentConnectionNodesCommentUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type ListCommentsIssueCommentsCommentConnectionNodesCommentUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The user's full name.
+	Name string `json:"name"`
+	// The user's email address.
+	Email string `json:"email"`
+}
+
+// GetId returns ListCommentsIssueCommentsCommentConnectionNodesCommentUser.Id, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesCommentUser) GetId() string { return v.Id }
+
+// GetName returns ListCommentsIssueCommentsCommentConnectionNodesCommentUser.Name, and is useful for accessing the field via an interface.
+func (v *ListCommentsIssueCommentsCommentConnectionNodesCommentUser) GetName() string { return v.Name }
 
 // GetEmail returns ListCommentsIssueCommentsCommentConnectionNodesCommentUser.Email, and is useful for accessing the field via an interface.
 func (v *ListCommentsIssueCommentsCommentConnectionNodesCommentUser) GetEmail() string {
@@ -6312,6 +6846,74 @@ type ListCommentsResponse struct {
 // GetIssue returns ListCommentsResponse.Issue, and is useful for accessing the field via an interface.
 func (v *ListCommentsResponse) GetIssue() *ListCommentsIssue { return v.Issue }
 
+// ListIssueLabelsIssueLabelsIssueLabelConnection includes the requested fields of the GraphQL type IssueLabelConnection.
+type ListIssueLabelsIssueLabelsIssueLabelConnection struct {
+	Nodes []*ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel `json:"nodes"`
+}
+
+// GetNodes returns ListIssueLabelsIssueLabelsIssueLabelConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsIssueLabelsIssueLabelConnection) GetNodes() []*ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel {
+	return v.Nodes
+}
+
+// ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel includes the requested fields of the GraphQL type IssueLabel.
+// The GraphQL type's documentation follows.
+//
+// Labels that can be associated with issues.
+type ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The label's name.
+	Name string `json:"name"`
+	// The team that the label is associated with. If null, the label is associated with the global workspace.
+	Team *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam `json:"team"`
+}
+
+// GetId returns ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Id, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetId() string { return v.Id }
+
+// GetName returns ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Name, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetName() string {
+	return v.Name
+}
+
+// GetTeam returns ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel.Team, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabel) GetTeam() *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam {
+	return v.Team
+}
+
+// ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam includes the requested fields of the GraphQL type Team.
+// The GraphQL type's documentation follows.
+//
+// An organizational unit that contains issues.
+type ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+	// The team's unique key. The key is used in URLs.
+	Key string `json:"key"`
+}
+
+// GetId returns ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam.Id, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) GetId() string {
+	return v.Id
+}
+
+// GetKey returns ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam.Key, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsIssueLabelsIssueLabelConnectionNodesIssueLabelTeam) GetKey() string {
+	return v.Key
+}
+
+// ListIssueLabelsResponse is returned by ListIssueLabels on success.
+type ListIssueLabelsResponse struct {
+	// All issue labels.
+	IssueLabels *ListIssueLabelsIssueLabelsIssueLabelConnection `json:"issueLabels"`
+}
+
+// GetIssueLabels returns ListIssueLabelsResponse.IssueLabels, and is useful for accessing the field via an interface.
+func (v *ListIssueLabelsResponse) GetIssueLabels() *ListIssueLabelsIssueLabelsIssueLabelConnection {
+	return v.IssueLabels
+}
+
 // ListIssuesIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
 type ListIssuesIssuesIssueConnection struct {
 	Nodes    []*ListIssuesIssuesIssueConnectionNodesIssue `json:"nodes"`
@@ -6506,6 +7108,284 @@ type ListIssuesResponse struct {
 // GetIssues returns ListIssuesResponse.Issues, and is useful for accessing the field via an interface.
 func (v *ListIssuesResponse) GetIssues() *ListIssuesIssuesIssueConnection { return v.Issues }
 
+// ListIssuesWithRespondersIssuesIssueConnection includes the requested fields of the GraphQL type IssueConnection.
+type ListIssuesWithRespondersIssuesIssueConnection struct {
+	Nodes    []*ListIssuesWithRespondersIssuesIssueConnectionNodesIssue `json:"nodes"`
+	PageInfo *ListIssuesWithRespondersIssuesIssueConnectionPageInfo     `json:"pageInfo"`
+}
+
+// GetNodes returns ListIssuesWithRespondersIssuesIssueConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnection) GetNodes() []*ListIssuesWithRespondersIssuesIssueConnectionNodesIssue {
+	return v.Nodes
+}
+
+// GetPageInfo returns ListIssuesWithRespondersIssuesIssueConnection.PageInfo, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnection) GetPageInfo() *ListIssuesWithRespondersIssuesIssueConnectionPageInfo {
+	return v.PageInfo
+}
+
+// ListIssuesWithRespondersIssuesIssueConnectionNodesIssue includes the requested fields of the GraphQL type Issue.
+// The GraphQL type's documentation follows.
+//
+// An issue.
+type ListIssuesWithRespondersIssuesIssueConnectionNodesIssue struct {
+	IssueListFields `json:"-"`
+	// The user who created the issue.
+	Creator *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser `json:"creator"`
+	// Comments associated with the issue.
+	Comments *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection `json:"comments"`
+}
+
+// GetCreator returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Creator, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetCreator() *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser {
+	return v.Creator
+}
+
+// GetComments returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Comments, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetComments() *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection {
+	return v.Comments
+}
+
+// GetId returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Id, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetId() string {
+	return v.IssueListFields.Id
+}
+
+// GetIdentifier returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Identifier, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetIdentifier() string {
+	return v.IssueListFields.Identifier
+}
+
+// GetTitle returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Title, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetTitle() string {
+	return v.IssueListFields.Title
+}
+
+// GetDescription returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Description, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetDescription() *string {
+	return v.IssueListFields.Description
+}
+
+// GetPriority returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Priority, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetPriority() float64 {
+	return v.IssueListFields.Priority
+}
+
+// GetEstimate returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Estimate, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetEstimate() *float64 {
+	return v.IssueListFields.Estimate
+}
+
+// GetCreatedAt returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.CreatedAt, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetCreatedAt() time.Time {
+	return v.IssueListFields.CreatedAt
+}
+
+// GetUpdatedAt returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetUpdatedAt() time.Time {
+	return v.IssueListFields.UpdatedAt
+}
+
+// GetDueDate returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.DueDate, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetDueDate() *string {
+	return v.IssueListFields.DueDate
+}
+
+// GetUrl returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Url, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetUrl() string {
+	return v.IssueListFields.Url
+}
+
+// GetState returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.State, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetState() *IssueListFieldsStateWorkflowState {
+	return v.IssueListFields.State
+}
+
+// GetAssignee returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Assignee, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetAssignee() *IssueListFieldsAssigneeUser {
+	return v.IssueListFields.Assignee
+}
+
+// GetTeam returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Team, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetTeam() *IssueListFieldsTeam {
+	return v.IssueListFields.Team
+}
+
+// GetLabels returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssue.Labels, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) GetLabels() *IssueListFieldsLabelsIssueLabelConnection {
+	return v.IssueListFields.Labels
+}
+
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ListIssuesWithRespondersIssuesIssueConnectionNodesIssue
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ListIssuesWithRespondersIssuesIssueConnectionNodesIssue = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.IssueListFields)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalListIssuesWithRespondersIssuesIssueConnectionNodesIssue struct {
+	Creator *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser `json:"creator"`
+
+	Comments *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection `json:"comments"`
+
+	Id string `json:"id"`
+
+	Identifier string `json:"identifier"`
+
+	Title string `json:"title"`
+
+	Description *string `json:"description"`
+
+	Priority float64 `json:"priority"`
+
+	Estimate *float64 `json:"estimate"`
+
+	CreatedAt time.Time `json:"createdAt"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+
+	DueDate *string `json:"dueDate"`
+
+	Url string `json:"url"`
+
+	State *IssueListFieldsStateWorkflowState `json:"state"`
+
+	Assignee *IssueListFieldsAssigneeUser `json:"assignee"`
+
+	Team *IssueListFieldsTeam `json:"team"`
+
+	Labels *IssueListFieldsLabelsIssueLabelConnection `json:"labels"`
+}
+
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssue) __premarshalJSON() (*__premarshalListIssuesWithRespondersIssuesIssueConnectionNodesIssue, error) {
+	var retval __premarshalListIssuesWithRespondersIssuesIssueConnectionNodesIssue
+
+	retval.Creator = v.Creator
+	retval.Comments = v.Comments
+	retval.Id = v.IssueListFields.Id
+	retval.Identifier = v.IssueListFields.Identifier
+	retval.Title = v.IssueListFields.Title
+	retval.Description = v.IssueListFields.Description
+	retval.Priority = v.IssueListFields.Priority
+	retval.Estimate = v.IssueListFields.Estimate
+	retval.CreatedAt = v.IssueListFields.CreatedAt
+	retval.UpdatedAt = v.IssueListFields.UpdatedAt
+	retval.DueDate = v.IssueListFields.DueDate
+	retval.Url = v.IssueListFields.Url
+	retval.State = v.IssueListFields.State
+	retval.Assignee = v.IssueListFields.Assignee
+	retval.Team = v.IssueListFields.Team
+	retval.Labels = v.IssueListFields.Labels
+	return &retval, nil
+}
+
+// ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection includes the requested fields of the GraphQL type CommentConnection.
+type ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection struct {
+	Nodes []*ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment `json:"nodes"`
+}
+
+// GetNodes returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection.Nodes, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnection) GetNodes() []*ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment {
+	return v.Nodes
+}
+
+// ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment includes the requested fields of the GraphQL type Comment.
+// The GraphQL type's documentation follows.
+//
+// A comment associated with an issue.
+type ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment struct {
+	// The user who wrote the comment.
+	User *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser `json:"user"`
+}
+
+// GetUser returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment.User, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesComment) GetUser() *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser {
+	return v.User
+}
+
+// ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser.Id, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCommentsCommentConnectionNodesCommentUser) GetId() string {
+	return v.Id
+}
+
+// ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser includes the requested fields of the GraphQL type User.
+// The GraphQL type's documentation follows.
+//
+// A user that has access to the the resources of an organization.
+type ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser struct {
+	// The unique identifier of the entity.
+	Id string `json:"id"`
+}
+
+// GetId returns ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser.Id, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionNodesIssueCreatorUser) GetId() string {
+	return v.Id
+}
+
+// ListIssuesWithRespondersIssuesIssueConnectionPageInfo includes the requested fields of the GraphQL type PageInfo.
+type ListIssuesWithRespondersIssuesIssueConnectionPageInfo struct {
+	// Indicates if there are more results when paginating forward.
+	HasNextPage bool `json:"hasNextPage"`
+	// Cursor representing the last result in the paginated results.
+	EndCursor *string `json:"endCursor"`
+}
+
+// GetHasNextPage returns ListIssuesWithRespondersIssuesIssueConnectionPageInfo.HasNextPage, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionPageInfo) GetHasNextPage() bool {
+	return v.HasNextPage
+}
+
+// GetEndCursor returns ListIssuesWithRespondersIssuesIssueConnectionPageInfo.EndCursor, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersIssuesIssueConnectionPageInfo) GetEndCursor() *string {
+	return v.EndCursor
+}
+
+// ListIssuesWithRespondersResponse is returned by ListIssuesWithResponders on success.
+type ListIssuesWithRespondersResponse struct {
+	// All issues.
+	Issues *ListIssuesWithRespondersIssuesIssueConnection `json:"issues"`
+}
+
+// GetIssues returns ListIssuesWithRespondersResponse.Issues, and is useful for accessing the field via an interface.
+func (v *ListIssuesWithRespondersResponse) GetIssues() *ListIssuesWithRespondersIssuesIssueConnection {
+	return v.Issues
+}
+
 // ListProjectsProjectsProjectConnection includes the requested fields of the GraphQL type ProjectConnection.
 type ListProjectsProjectsProjectConnection struct {
 	Nodes    []*ListProjectsProjectsProjectConnectionNodesProject `json:"nodes"`
@@ -12036,6 +12916,22 @@ func (v *__AttachmentUpdateInput) GetId() string { return v.Id }
 // GetInput returns __AttachmentUpdateInput.Input, and is useful for accessing the field via an interface.
 func (v *__AttachmentUpdateInput) GetInput() *AttachmentUpdateInput { return v.Input }
 
+// __CountIssuesInput is used internally by genqlient
+type __CountIssuesInput struct {
+	Filter *IssueFilter `json:"filter,omitempty"`
+	First  *int         `json:"first"`
+	After  *string      `json:"after"`
+}
+
+// GetFilter returns __CountIssuesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__CountIssuesInput) GetFilter() *IssueFilter { return v.Filter }
+
+// GetFirst returns __CountIssuesInput.First, and is useful for accessing the field via an interface.
+func (v *__CountIssuesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __CountIssuesInput.After, and is useful for accessing the field via an interface.
+func (v *__CountIssuesInput) GetAfter() *string { return v.After }
+
 // __CreateCommentInput is used internally by genqlient
 type __CreateCommentInput struct {
 	Input *CommentCreateInput `json:"input,omitempty"`
@@ -12084,6 +12980,14 @@ type __GetProjectInput struct {
 // GetId returns __GetProjectInput.Id, and is useful for accessing the field via an interface.
 func (v *__GetProjectInput) GetId() string { return v.Id }
 
+// __GetTeamCyclesInput is used internally by genqlient
+type __GetTeamCyclesInput struct {
+	Key string `json:"key"`
+}
+
+// GetKey returns __GetTeamCyclesInput.Key, and is useful for accessing the field via an interface.
+func (v *__GetTeamCyclesInput) GetKey() string { return v.Key }
+
 // __GetTeamInput is used internally by genqlient
 type __GetTeamInput struct {
 	Key string `json:"key"`
@@ -12116,6 +13020,14 @@ type __GetUserByEmailInput struct {
 // GetFilter returns __GetUserByEmailInput.Filter, and is useful for accessing the field via an interface.
 func (v *__GetUserByEmailInput) GetFilter() *UserFilter { return v.Filter }
 
+// __GetViewerTeamsInput is used internally by genqlient
+type __GetViewerTeamsInput struct {
+	First *int `json:"first"`
+}
+
+// GetFirst returns __GetViewerTeamsInput.First, and is useful for accessing the field via an interface.
+func (v *__GetViewerTeamsInput) GetFirst() *int { return v.First }
+
 // __ListAttachmentsInput is used internally by genqlient
 type __ListAttachmentsInput struct {
 	IssueId string             `json:"issueId"`
@@ -12136,6 +13048,22 @@ func (v *__ListAttachmentsInput) GetAfter() *string { return v.After }
 // GetOrderBy returns __ListAttachmentsInput.OrderBy, and is useful for accessing the field via an interface.
 func (v *__ListAttachmentsInput) GetOrderBy() *PaginationOrderBy { return v.OrderBy }
 
+// __ListBlockedIssuesInput is used internally by genqlient
+type __ListBlockedIssuesInput struct {
+	Filter *IssueFilter `json:"filter,omitempty"`
+	First  *int         `json:"first"`
+	After  *string      `json:"after"`
+}
+
+// GetFilter returns __ListBlockedIssuesInput.Filter, and is useful for accessing the field via an interface.
+func (v *__ListBlockedIssuesInput) GetFilter() *IssueFilter { return v.Filter }
+
+// GetFirst returns __ListBlockedIssuesInput.First, and is useful for accessing the field via an interface.
+func (v *__ListBlockedIssuesInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __ListBlockedIssuesInput.After, and is useful for accessing the field via an interface.
+func (v *__ListBlockedIssuesInput) GetAfter() *string { return v.After }
+
 // __ListCommentsInput is used internally by genqlient
 type __ListCommentsInput struct {
 	Id      string             `json:"id"`
@@ -12156,6 +13084,18 @@ func (v *__ListCommentsInput) GetAfter() *string { return v.After }
 // GetOrderBy returns __ListCommentsInput.OrderBy, and is useful for accessing the field via an interface.
 func (v *__ListCommentsInput) GetOrderBy() *PaginationOrderBy { return v.OrderBy }
 
+// __ListIssueLabelsInput is used internally by genqlient
+type __ListIssueLabelsInput struct {
+	Filter *IssueLabelFilter `json:"filter,omitempty"`
+	First  *int              `json:"first"`
+}
+
+// GetFilter returns __ListIssueLabelsInput.Filter, and is useful for accessing the field via an interface.
+func (v *__ListIssueLabelsInput) GetFilter() *IssueLabelFilter { return v.Filter }
+
+// GetFirst returns __ListIssueLabelsInput.First, and is useful for accessing the field via an interface.
+func (v *__ListIssueLabelsInput) GetFirst() *int { return v.First }
+
 // __ListIssuesInput is used internally by genqlient
 type __ListIssuesInput struct {
 	Filter  *IssueFilter       `json:"filter,omitempty"`
@@ -12176,6 +13116,26 @@ func (v *__ListIssuesInput) GetAfter() *string { return v.After }
 // GetOrderBy returns __ListIssuesInput.OrderBy, and is useful for accessing the field via an interface.
 func (v *__ListIssuesInput) GetOrderBy() *PaginationOrderBy { return v.OrderBy }
 
+// __ListIssuesWithRespondersInput is used internally by genqlient
+type __ListIssuesWithRespondersInput struct {
+	Filter  *IssueFilter       `json:"filter,omitempty"`
+	First   *int               `json:"first"`
+	After   *string            `json:"after"`
+	OrderBy *PaginationOrderBy `json:"orderBy"`
+}
+
+// GetFilter returns __ListIssuesWithRespondersInput.Filter, and is useful for accessing the field via an interface.
+func (v *__ListIssuesWithRespondersInput) GetFilter() *IssueFilter { return v.Filter }
+
+// GetFirst returns __ListIssuesWithRespondersInput.First, and is useful for accessing the field via an interface.
+func (v *__ListIssuesWithRespondersInput) GetFirst() *int { return v.First }
+
+// GetAfter returns __ListIssuesWithRespondersInput.After, and is useful for accessing the field via an interface.
+func (v *__ListIssuesWithRespondersInput) GetAfter() *string { return v.After }
+
+// GetOrderBy returns __ListIssuesWithRespondersInput.OrderBy, and is useful for accessing the field via an interface.
+func (v *__ListIssuesWithRespondersInput) GetOrderBy() *PaginationOrderBy { return v.OrderBy }
+
 // __ListProjectsInput is used internally by genqlient
 type __ListProjectsInput struct {
 	Filter  *ProjectFilter     `json:"filter,omitempty"`
@@ -12399,6 +13359,51 @@ func AttachmentUpdate(
 	return data_, err_
 }
 
+// The query executed by CountIssues.
+const CountIssues_Operation = `
+query CountIssues ($filter: IssueFilter, $first: Int, $after: String) {
+	issues(filter: $filter, first: $first, after: $after) {
+		nodes {
+			id
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+// Query: Page through matching issue IDs only, for counting
+func CountIssues(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter *IssueFilter,
+	first *int,
+	after *string,
+) (data_ *CountIssuesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "CountIssues",
+		Query:  CountIssues_Operation,
+		Variables: &__CountIssuesInput{
+			Filter: filter,
+			First:  first,
+			After:  after,
+		},
+	}
+
+	data_ = &CountIssuesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The mutation executed by CreateComment.
 const CreateComment_Operation = `
 mutation CreateComment ($input: CommentCreateInput!) {
@@ -12648,6 +13653,7 @@ fragment IssueDetailFields on Issue {
 		cycleStartDay
 		cycleDuration
 		upcomingCycleCount
+		issueEstimationType
 		states {
 			nodes {
 				id
@@ -13089,6 +14095,59 @@ func GetTeam(
 	return data_, err_
 }
 
+// The query executed by GetTeamCycles.
+const GetTeamCycles_Operation = `
+query GetTeamCycles ($key: String!) {
+	team(id: $key) {
+		id
+		key
+		cyclesEnabled
+		activeCycle {
+			id
+			number
+			name
+			startsAt
+			endsAt
+		}
+		cycles(filter: {isNext:{eq:true}}, first: 1) {
+			nodes {
+				id
+				number
+				name
+				startsAt
+				endsAt
+			}
+		}
+	}
+}
+`
+
+// Query: Get a team's active and next cycles (for --cycle keywords)
+func GetTeamCycles(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	key string,
+) (data_ *GetTeamCyclesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetTeamCycles",
+		Query:  GetTeamCycles_Operation,
+		Variables: &__GetTeamCyclesInput{
+			Key: key,
+		},
+	}
+
+	data_ = &GetTeamCyclesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by GetTeamMembers.
 const GetTeamMembers_Operation = `
 query GetTeamMembers ($key: String!) {
@@ -13273,6 +14332,53 @@ func GetViewer(
 	return data_, err_
 }
 
+// The query executed by GetViewerTeams.
+const GetViewerTeams_Operation = `
+query GetViewerTeams ($first: Int) {
+	viewer {
+		id
+		teamMemberships(first: $first) {
+			nodes {
+				id
+				owner
+				team {
+					id
+					key
+					name
+					private
+				}
+			}
+		}
+	}
+}
+`
+
+// Query: Get the current user's team memberships
+func GetViewerTeams(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	first *int,
+) (data_ *GetViewerTeamsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "GetViewerTeams",
+		Query:  GetViewerTeams_Operation,
+		Variables: &__GetViewerTeamsInput{
+			First: first,
+		},
+	}
+
+	data_ = &GetViewerTeamsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by ListAttachments.
 const ListAttachments_Operation = `
 query ListAttachments ($issueId: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
@@ -13333,6 +14439,78 @@ func ListAttachments(
 	return data_, err_
 }
 
+// The query executed by ListBlockedIssues.
+const ListBlockedIssues_Operation = `
+query ListBlockedIssues ($filter: IssueFilter, $first: Int, $after: String) {
+	issues(filter: $filter, first: $first, after: $after) {
+		nodes {
+			id
+			identifier
+			title
+			url
+			state {
+				name
+				type
+			}
+			inverseRelations(first: 50) {
+				nodes {
+					type
+					issue {
+						id
+						identifier
+						title
+						url
+						state {
+							name
+							type
+						}
+						assignee {
+							id
+							name
+							email
+						}
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+`
+
+// Query: List blocked issues together with the issues blocking them
+func ListBlockedIssues(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter *IssueFilter,
+	first *int,
+	after *string,
+) (data_ *ListBlockedIssuesResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListBlockedIssues",
+		Query:  ListBlockedIssues_Operation,
+		Variables: &__ListBlockedIssuesInput{
+			Filter: filter,
+			First:  first,
+			After:  after,
+		},
+	}
+
+	data_ = &ListBlockedIssuesResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by ListComments.
 const ListComments_Operation = `
 query ListComments ($id: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
@@ -13343,11 +14521,15 @@ query ListComments ($id: String!, $first: Int, $after: String, $orderBy: Paginat
 				body
 				createdAt
 				updatedAt
+				editedAt
 				user {
 					id
 					name
 					email
 				}
+				parent {
+					id
+				}
 			}
 			pageInfo {
 				hasNextPage
@@ -13390,6 +14572,50 @@ func ListComments(
 	return data_, err_
 }
 
+// The query executed by ListIssueLabels.
+const ListIssueLabels_Operation = `
+query ListIssueLabels ($filter: IssueLabelFilter, $first: Int) {
+	issueLabels(filter: $filter, first: $first) {
+		nodes {
+			id
+			name
+			team {
+				id
+				key
+			}
+		}
+	}
+}
+`
+
+// Query: Look up issue labels by name (for --input-file labels)
+func ListIssueLabels(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter *IssueLabelFilter,
+	first *int,
+) (data_ *ListIssueLabelsResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListIssueLabels",
+		Query:  ListIssueLabels_Operation,
+		Variables: &__ListIssueLabelsInput{
+			Filter: filter,
+			First:  first,
+		},
+	}
+
+	data_ = &ListIssueLabelsResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by ListIssues.
 const ListIssues_Operation = `
 query ListIssues ($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
@@ -13472,6 +14698,99 @@ func ListIssues(
 	return data_, err_
 }
 
+// The query executed by ListIssuesWithResponders.
+const ListIssuesWithResponders_Operation = `
+query ListIssuesWithResponders ($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+	issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+		nodes {
+			... IssueListFields
+			creator {
+				id
+			}
+			comments(first: 50) {
+				nodes {
+					user {
+						id
+					}
+				}
+			}
+		}
+		pageInfo {
+			hasNextPage
+			endCursor
+		}
+	}
+}
+fragment IssueListFields on Issue {
+	id
+	identifier
+	title
+	description
+	priority
+	estimate
+	createdAt
+	updatedAt
+	dueDate
+	url
+	state {
+		id
+		name
+		type
+		color
+	}
+	assignee {
+		id
+		name
+		email
+	}
+	team {
+		id
+		key
+		name
+	}
+	labels {
+		nodes {
+			id
+			name
+			color
+		}
+	}
+}
+`
+
+// Query: List issues with their creator and comment authors, used to find
+// issues still waiting for a first response
+func ListIssuesWithResponders(
+	ctx_ context.Context,
+	client_ graphql.Client,
+	filter *IssueFilter,
+	first *int,
+	after *string,
+	orderBy *PaginationOrderBy,
+) (data_ *ListIssuesWithRespondersResponse, err_ error) {
+	req_ := &graphql.Request{
+		OpName: "ListIssuesWithResponders",
+		Query:  ListIssuesWithResponders_Operation,
+		Variables: &__ListIssuesWithRespondersInput{
+			Filter:  filter,
+			First:   first,
+			After:   after,
+			OrderBy: orderBy,
+		},
+	}
+
+	data_ = &ListIssuesWithRespondersResponse{}
+	resp_ := &graphql.Response{Data: data_}
+
+	err_ = client_.MakeRequest(
+		ctx_,
+		req_,
+		resp_,
+	)
+
+	return data_, err_
+}
+
 // The query executed by ListProjects.
 const ListProjects_Operation = `
 query ListProjects ($filter: ProjectFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
@@ -1,8 +1,10 @@
 package output
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -18,12 +20,66 @@ type TableData struct {
 
 // JSON outputs data as JSON
 func JSON(data interface{}) {
-	jsonData, err := json.MarshalIndent(data, "", "  ")
-	if err != nil {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(data); err != nil {
 		fmt.Fprintf(os.Stderr, "Error marshaling JSON: %v\n", err)
 		os.Exit(1)
 	}
-	fmt.Println(string(jsonData))
+}
+
+// JSONArrayStream writes a JSON array one element at a time, so large result
+// sets can be printed page by page instead of being held in memory. The
+// output is identical to JSON for the equivalent slice.
+type JSONArrayStream struct {
+	w       io.Writer
+	buf     bytes.Buffer
+	encoder *json.Encoder
+	count   int
+}
+
+// NewJSONArrayStream returns a stream that writes to w
+func NewJSONArrayStream(w io.Writer) *JSONArrayStream {
+	s := &JSONArrayStream{w: w}
+	s.encoder = json.NewEncoder(&s.buf)
+	s.encoder.SetIndent("  ", "  ")
+	return s
+}
+
+// Write appends one element to the array
+func (s *JSONArrayStream) Write(v interface{}) error {
+	s.buf.Reset()
+	if s.count == 0 {
+		s.buf.WriteString("[\n  ")
+	} else {
+		s.buf.WriteString(",\n  ")
+	}
+	if err := s.encoder.Encode(v); err != nil {
+		return err
+	}
+	// Encode ends each value with a newline; the next separator supplies it
+	s.buf.Truncate(s.buf.Len() - 1)
+
+	if _, err := s.w.Write(s.buf.Bytes()); err != nil {
+		return err
+	}
+	s.count++
+	return nil
+}
+
+// Len returns the number of elements written so far
+func (s *JSONArrayStream) Len() int {
+	return s.count
+}
+
+// Close terminates the array, writing [] if no elements were written
+func (s *JSONArrayStream) Close() error {
+	closing := "\n]\n"
+	if s.count == 0 {
+		closing = "[]\n"
+	}
+	_, err := io.WriteString(s.w, closing)
+	return err
 }
 
 // Error outputs an error message
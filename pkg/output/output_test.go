@@ -0,0 +1,158 @@
+package output
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"testing"
+)
+
+type testItem struct {
+	ID     string            `json:"id"`
+	Title  string            `json:"title"`
+	Labels []string          `json:"labels"`
+	Meta   map[string]string `json:"meta,omitempty"`
+	Parent *testItem         `json:"parent"`
+}
+
+func testItems(n int) []*testItem {
+	items := make([]*testItem, n)
+	for i := range items {
+		items[i] = &testItem{
+			ID:     fmt.Sprintf("ISS-%d", i),
+			Title:  fmt.Sprintf("Issue <%d> & \"friends\"", i),
+			Labels: []string{"bug", "frontend"},
+		}
+		if i%2 == 1 {
+			items[i].Meta = map[string]string{"source": "import"}
+			items[i].Parent = items[i-1]
+		}
+	}
+	return items
+}
+
+// captureStdout returns what fn writes to os.Stdout
+func captureStdout(t testing.TB, fn func()) []byte {
+	t.Helper()
+	f, err := os.CreateTemp(t.TempDir(), "stdout")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	orig := os.Stdout
+	os.Stdout = f
+	defer func() { os.Stdout = orig }()
+	fn()
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestJSONArrayStreamMatchesJSON(t *testing.T) {
+	for _, n := range []int{0, 1, 7} {
+		t.Run(fmt.Sprintf("%d elements", n), func(t *testing.T) {
+			items := testItems(n)
+			want := captureStdout(t, func() { JSON(items) })
+
+			var got bytes.Buffer
+			stream := NewJSONArrayStream(&got)
+			for _, item := range items {
+				if err := stream.Write(item); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := stream.Close(); err != nil {
+				t.Fatal(err)
+			}
+
+			if stream.Len() != n {
+				t.Errorf("Len() = %d, want %d", stream.Len(), n)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("stream output differs from JSON\n got: %s\nwant: %s", got.Bytes(), want)
+			}
+		})
+	}
+}
+
+// The benchmarks compare memory for a large export: JSON needs the whole
+// slice in memory, while the stream only holds one element at a time. Besides
+// the usual allocation counts they report live-B/op, the heap still in use
+// while the output is being written.
+const benchmarkItems = 50000
+
+// liveHeap returns the bytes of heap in use after a garbage collection
+func liveHeap() uint64 {
+	runtime.GC()
+	var m runtime.MemStats
+	runtime.ReadMemStats(&m)
+	return m.HeapAlloc
+}
+
+func BenchmarkJSON(b *testing.B) {
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+	defer devNull.Close()
+	orig := os.Stdout
+	os.Stdout = devNull
+	defer func() { os.Stdout = orig }()
+
+	b.ReportAllocs()
+	var live uint64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		base := liveHeap()
+		b.StartTimer()
+
+		items := testItems(benchmarkItems)
+		JSON(items)
+
+		b.StopTimer()
+		if heap := liveHeap(); heap > base {
+			live += heap - base
+		}
+		runtime.KeepAlive(items)
+		b.StartTimer()
+	}
+	b.ReportMetric(float64(live)/float64(b.N), "live-B/op")
+}
+
+func BenchmarkJSONArrayStream(b *testing.B) {
+	b.ReportAllocs()
+	var live uint64
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		base := liveHeap()
+		b.StartTimer()
+
+		stream := NewJSONArrayStream(io.Discard)
+		for j := 0; j < benchmarkItems; j++ {
+			if err := stream.Write(testItems(1)[0]); err != nil {
+				b.Fatal(err)
+			}
+		}
+
+		b.StopTimer()
+		if heap := liveHeap(); heap > base {
+			live += heap - base
+		}
+		runtime.KeepAlive(stream)
+		b.StartTimer()
+
+		if err := stream.Close(); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(live)/float64(b.N), "live-B/op")
+}
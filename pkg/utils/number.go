@@ -0,0 +1,78 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// NumberFormat formats counts, decimals, and percentages for a locale.
+// The zero value keeps Go's default formatting ("1234", "2.5", "50%").
+type NumberFormat struct {
+	printer *message.Printer
+}
+
+// NewNumberFormat returns a NumberFormat for a locale such as "de", "fr-FR",
+// or a POSIX-style value like "de_DE.UTF-8". Empty, "C", and "POSIX" keep the
+// default formatting, as does any locale that cannot be parsed.
+func NewNumberFormat(locale string) NumberFormat {
+	tag, ok := ParseLocale(locale)
+	if !ok {
+		return NumberFormat{}
+	}
+	return NumberFormat{printer: message.NewPrinter(tag)}
+}
+
+// ParseLocale converts a BCP 47 or POSIX locale string into a language tag.
+// It reports false for empty, "C", "POSIX", and unparseable values.
+func ParseLocale(locale string) (language.Tag, bool) {
+	locale = strings.TrimSpace(locale)
+	// Drop POSIX codeset and modifier suffixes: de_DE.UTF-8@euro -> de_DE
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	if locale == "" || locale == "C" || locale == "POSIX" {
+		return language.Und, false
+	}
+
+	tag, err := language.Parse(strings.ReplaceAll(locale, "_", "-"))
+	if err != nil {
+		return language.Und, false
+	}
+	return tag, true
+}
+
+// Count formats an integer count with locale-specific grouping
+func (f NumberFormat) Count(n int) string {
+	if f.printer == nil {
+		return fmt.Sprintf("%d", n)
+	}
+	return f.printer.Sprint(number.Decimal(n))
+}
+
+// Decimal formats a number with locale-specific separators
+func (f NumberFormat) Decimal(v float64) string {
+	if f.printer == nil {
+		return fmt.Sprintf("%g", v)
+	}
+	return f.printer.Sprint(number.Decimal(v))
+}
+
+// Fixed formats a number with exactly digits fraction digits
+func (f NumberFormat) Fixed(v float64, digits int) string {
+	if f.printer == nil {
+		return fmt.Sprintf("%.*f", digits, v)
+	}
+	return f.printer.Sprint(number.Decimal(v, number.MinFractionDigits(digits), number.MaxFractionDigits(digits)))
+}
+
+// Percent formats a 0-1 fraction as a whole-number percentage
+func (f NumberFormat) Percent(fraction float64) string {
+	if f.printer == nil {
+		return fmt.Sprintf("%.0f%%", fraction*100)
+	}
+	return f.printer.Sprint(number.Percent(fraction, number.MaxFractionDigits(0)))
+}
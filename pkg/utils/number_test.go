@@ -0,0 +1,62 @@
+package utils
+
+import "testing"
+
+func TestNumberFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		format  NumberFormat
+		count   string
+		decimal string
+		fixed   string
+		percent string
+	}{
+		{"zero value", NumberFormat{}, "1234567", "1234.5", "0.50", "50%"},
+		{"empty locale", NewNumberFormat(""), "1234567", "1234.5", "0.50", "50%"},
+		{"C.UTF-8", NewNumberFormat("C.UTF-8"), "1234567", "1234.5", "0.50", "50%"},
+		{"en", NewNumberFormat("en"), "1,234,567", "1,234.5", "0.50", "50%"},
+		{"de_DE.UTF-8", NewNumberFormat("de_DE.UTF-8"), "1.234.567", "1.234,5", "0,50", "50\u00a0%"},
+		{"fr_FR", NewNumberFormat("fr_FR"), "1\u00a0234\u00a0567", "1\u00a0234,5", "0,50", "50\u00a0%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.format.Count(1234567); got != tt.count {
+				t.Errorf("Count(1234567) = %q, want %q", got, tt.count)
+			}
+			if got := tt.format.Decimal(1234.5); got != tt.decimal {
+				t.Errorf("Decimal(1234.5) = %q, want %q", got, tt.decimal)
+			}
+			if got := tt.format.Fixed(0.5, 2); got != tt.fixed {
+				t.Errorf("Fixed(0.5, 2) = %q, want %q", got, tt.fixed)
+			}
+			if got := tt.format.Percent(0.5); got != tt.percent {
+				t.Errorf("Percent(0.5) = %q, want %q", got, tt.percent)
+			}
+		})
+	}
+}
+
+func TestParseLocale(t *testing.T) {
+	tests := []struct {
+		locale string
+		want   string
+		ok     bool
+	}{
+		{"de", "de", true},
+		{"fr_FR", "fr-FR", true},
+		{"de_DE.UTF-8@euro", "de-DE", true},
+		{"", "und", false},
+		{"C", "und", false},
+		{"C.UTF-8", "und", false},
+		{"POSIX", "und", false},
+		{"not a locale", "und", false},
+	}
+
+	for _, tt := range tests {
+		tag, ok := ParseLocale(tt.locale)
+		if ok != tt.ok || tag.String() != tt.want {
+			t.Errorf("ParseLocale(%q) = %s, %v; want %s, %v", tt.locale, tag, ok, tt.want, tt.ok)
+		}
+	}
+}
@@ -7,13 +7,16 @@ import (
 	"time"
 )
 
+// DefaultTimeExpression is the creation window applied when no --newer-than value is given
+const DefaultTimeExpression = "6_months_ago"
+
 // ParseTimeExpression converts time expressions like "3_weeks_ago" into ISO8601 datetime strings
 // Returns empty string for "all_time"
-// Default is "6_months_ago" if empty string is provided
+// Default is DefaultTimeExpression if empty string is provided
 func ParseTimeExpression(expr string) (string, error) {
 	// Handle empty input - use default
 	if expr == "" {
-		expr = "6_months_ago"
+		expr = DefaultTimeExpression
 	}
 
 	// Handle special case
@@ -70,3 +73,15 @@ func ParseTimeExpression(expr string) (string, error) {
 	// Return as ISO8601 string
 	return targetTime.Format(time.RFC3339), nil
 }
+
+// DescribeTimeExpression renders a relative time expression for humans,
+// e.g. "6_months_ago" becomes "6 months". Other expressions are returned as-is.
+func DescribeTimeExpression(expr string) string {
+	if expr == "" {
+		expr = DefaultTimeExpression
+	}
+	if strings.HasSuffix(expr, "_ago") {
+		return strings.ReplaceAll(strings.TrimSuffix(expr, "_ago"), "_", " ")
+	}
+	return expr
+}